@@ -16,9 +16,11 @@ type Config struct {
 	Debug      bool
 	LogLevel   string
 	HMACSecret string
+	SecretKey  string // 모니터 인증 정보 암호화 키
 
 	DB         DBConfig
 	Recaptcha  RecaptchaConfig
+	SMTP       SMTPConfig
 	CORSOrigin []string
 }
 
@@ -35,6 +37,15 @@ type RecaptchaConfig struct {
 	SecretKey string
 }
 
+type SMTPConfig struct {
+	Host        string
+	Port        string
+	Username    string
+	Password    string
+	From        string
+	ImplicitTLS bool // 465 포트처럼 접속부터 TLS 를 쓰는 경우
+}
+
 var AppConfig Config
 
 func Init() {
@@ -50,6 +61,7 @@ func Init() {
 		Debug:      get("DEBUG", "false") == "true",
 		LogLevel:   get("LOG_LEVEL", "info"),
 		HMACSecret: get("HMAC_SECRET", ""),
+		SecretKey:  get("SECRET_KEY", ""),
 
 		DB: DBConfig{
 			Host:     get("PG_DB_HOST", "localhost"),
@@ -64,6 +76,15 @@ func Init() {
 			SecretKey: get("RECAPTCHA_SECRET_KEY", ""),
 		},
 
+		SMTP: SMTPConfig{
+			Host:        get("SMTP_HOST", "localhost"),
+			Port:        get("SMTP_PORT", "587"),
+			Username:    get("SMTP_USERNAME", ""),
+			Password:    get("SMTP_PASSWORD", ""),
+			From:        get("SMTP_FROM", "keeplo <noreply@keeplo.local>"),
+			ImplicitTLS: get("SMTP_IMPLICIT_TLS", "false") == "true",
+		},
+
 		CORSOrigin: strings.Split(get("WHITE_LIST", ""), ","),
 	}
 
@@ -77,6 +98,10 @@ func get(key, def string) string {
 	return def
 }
 
+func (s SMTPConfig) Addr() string {
+	return s.Host + ":" + s.Port
+}
+
 // Data Source Name
 func (d DBConfig) DSN() string {
 	return fmt.Sprintf(
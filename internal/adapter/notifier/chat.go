@@ -0,0 +1,87 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"keeplo/internal/domain/notification"
+	"net/http"
+	"time"
+)
+
+const chatTimeout = 10 * time.Second
+
+// 채팅 메시지 공통 문구
+type chatMessage struct {
+	Title   string
+	Color   int // Discord embed 색상
+	Emoji   string
+	Fields  [][2]string
+	Message string
+}
+
+func newChatMessage(ev *notification.Event) chatMessage {
+	msg := chatMessage{
+		Title: "장애 발생",
+		Color: 0xE5484D,
+		Emoji: "🔴",
+	}
+	switch ev.Type {
+	case notification.EventIncidentResolved:
+		msg.Title = "정상 복구"
+		msg.Color = 0x30A46C
+		msg.Emoji = "🟢"
+	case notification.EventTest:
+		return chatMessage{
+			Title:   "keeplo 알림 테스트",
+			Color:   0x3E63DD,
+			Emoji:   "🔔",
+			Fields:  [][2]string{{"시각", ev.OccurredAt.Format(time.RFC3339)}},
+			Message: ev.Message,
+		}
+	}
+
+	if ev.Monitor != nil {
+		msg.Title = ev.Monitor.Name + " " + msg.Title
+		msg.Fields = append(msg.Fields,
+			[2]string{"대상", ev.Monitor.Target},
+			[2]string{"프로토콜", ev.Monitor.Type},
+		)
+	}
+	msg.Fields = append(msg.Fields,
+		[2]string{"상태", ev.PreviousStatus + " → " + ev.NewStatus},
+		[2]string{"응답 시간", fmt.Sprintf("%dms", ev.ResponseMs)},
+		[2]string{"시각", ev.OccurredAt.Format(time.RFC3339)},
+		[2]string{"인시던트", ev.IncidentID},
+	)
+	msg.Message = ev.Message
+	return msg
+}
+
+func postJSON(ctx context.Context, client *http.Client, url string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("invalid request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("responded with status %d: %s", resp.StatusCode, bytes.TrimSpace(detail))
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	return nil
+}
@@ -0,0 +1,104 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"keeplo/internal/domain/monitor"
+	"keeplo/internal/domain/notification"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type capturedRequest struct {
+	path string
+	body map[string]any
+}
+
+func startChatStub(t *testing.T, status int) (*httptest.Server, <-chan capturedRequest) {
+	t.Helper()
+
+	captured := make(chan capturedRequest, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		var body map[string]any
+		_ = json.Unmarshal(raw, &body)
+		captured <- capturedRequest{path: r.URL.Path, body: body}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, captured
+}
+
+func newTestEvent(eventType string) *notification.Event {
+	return &notification.Event{
+		Type:           eventType,
+		Monitor:        &monitor.Monitor{ID: uuid.New(), Name: "api", Type: "http", Target: "http://api.example.com:80"},
+		PreviousStatus: "up",
+		NewStatus:      "down",
+		IncidentID:     uuid.NewString(),
+		ResponseMs:     42,
+		Message:        "HTTP status 503",
+		OccurredAt:     time.Now(),
+	}
+}
+
+func TestSlackNotifier_Send(t *testing.T) {
+	srv, captured := startChatStub(t, http.StatusOK)
+	ch := &notification.Channel{Type: notification.ChannelSlack, Target: srv.URL + "/services/T000/B000"}
+
+	require.NoError(t, NewSlackNotifier().Send(context.Background(), ch, newTestEvent(notification.EventIncidentOpened)))
+
+	req := <-captured
+	assert.Equal(t, "/services/T000/B000", req.path)
+	assert.Contains(t, req.body["text"], "api 장애 발생")
+	blocks := req.body["blocks"].([]any)
+	assert.Equal(t, "header", blocks[0].(map[string]any)["type"])
+	assert.Len(t, blocks, 3)
+}
+
+func TestDiscordNotifier_Send(t *testing.T) {
+	srv, captured := startChatStub(t, http.StatusNoContent)
+	ch := &notification.Channel{Type: notification.ChannelDiscord, Target: srv.URL + "/api/webhooks/1/abc"}
+
+	ev := newTestEvent(notification.EventIncidentResolved)
+	require.NoError(t, NewDiscordNotifier().Send(context.Background(), ch, ev))
+
+	req := <-captured
+	embed := req.body["embeds"].([]any)[0].(map[string]any)
+	assert.Contains(t, embed["title"], "api 정상 복구")
+	assert.Equal(t, float64(0x30A46C), embed["color"])
+	assert.Equal(t, ev.OccurredAt.Format(time.RFC3339), embed["timestamp"])
+}
+
+func TestTelegramNotifier_Send(t *testing.T) {
+	srv, captured := startChatStub(t, http.StatusOK)
+	n := NewTelegramNotifier()
+	n.apiBase = srv.URL
+	ch := &notification.Channel{Type: notification.ChannelTelegram, Target: "-100123", Secret: "123:token"}
+
+	require.NoError(t, n.Send(context.Background(), ch, newTestEvent(notification.EventIncidentOpened)))
+
+	req := <-captured
+	assert.Equal(t, "/bot123:token/sendMessage", req.path)
+	assert.Equal(t, "-100123", req.body["chat_id"])
+	assert.Equal(t, "HTML", req.body["parse_mode"])
+	assert.Contains(t, req.body["text"], "<b>api 장애 발생</b>")
+}
+
+func TestTelegramNotifier_HidesTokenOnError(t *testing.T) {
+	srv, _ := startChatStub(t, http.StatusUnauthorized)
+	n := NewTelegramNotifier()
+	n.apiBase = srv.URL
+	ch := &notification.Channel{Type: notification.ChannelTelegram, Target: "-100123", Secret: "123:token"}
+
+	err := n.Send(context.Background(), ch, newTestEvent(notification.EventIncidentOpened))
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "123:token")
+}
@@ -0,0 +1,60 @@
+package notifier
+
+import (
+	"context"
+	"keeplo/internal/domain/notification"
+	"net/http"
+	"time"
+)
+
+type discordField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline"`
+}
+
+type discordEmbed struct {
+	Title       string         `json:"title"`
+	Description string         `json:"description,omitempty"`
+	Color       int            `json:"color"`
+	Fields      []discordField `json:"fields"`
+	Timestamp   string         `json:"timestamp"`
+}
+
+type discordPayload struct {
+	Username string         `json:"username"`
+	Embeds   []discordEmbed `json:"embeds"`
+}
+
+// Discord webhook (채널 Target 에 webhook URL)
+type DiscordNotifier struct {
+	client *http.Client
+}
+
+func NewDiscordNotifier() *DiscordNotifier {
+	return &DiscordNotifier{client: &http.Client{Timeout: chatTimeout}}
+}
+
+func (d *DiscordNotifier) Send(ctx context.Context, ch *notification.Channel, ev *notification.Event) error {
+	return postJSON(ctx, d.client, ch.Target, newDiscordPayload(ev))
+}
+
+func newDiscordPayload(ev *notification.Event) discordPayload {
+	msg := newChatMessage(ev)
+
+	fields := make([]discordField, 0, len(msg.Fields))
+	for _, f := range msg.Fields {
+		fields = append(fields, discordField{Name: f[0], Value: f[1], Inline: true})
+	}
+
+	return discordPayload{
+		Username: "keeplo",
+		Embeds: []discordEmbed{{
+			Title:       msg.Emoji + " " + msg.Title,
+			Description: msg.Message,
+			Color:       msg.Color,
+			Fields:      fields,
+			Timestamp:   ev.OccurredAt.Format(time.RFC3339),
+		}},
+	}
+}
@@ -0,0 +1,243 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"keeplo/config"
+	"keeplo/internal/domain/notification"
+	"keeplo/internal/domain/user"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"text/template"
+	"time"
+)
+
+const emailTimeout = 15 * time.Second
+
+// 이벤트 종류별 제목/본문 템플릿
+type EmailTemplate struct {
+	Subject *template.Template
+	Body    *template.Template
+}
+
+var defaultEmailTemplates = map[string]EmailTemplate{
+	notification.EventIncidentOpened: {
+		Subject: template.Must(template.New("subject").Parse(`[keeplo] {{.MonitorName}} 장애 발생`)),
+		Body: template.Must(template.New("body").Parse(`모니터링 대상에서 장애가 감지되었습니다.
+
+모니터: {{.MonitorName}} ({{.MonitorType}})
+대상: {{.Target}}
+상태: {{.PreviousStatus}} -> {{.NewStatus}}
+발생 시각: {{.OccurredAt}}
+응답 시간: {{.ResponseMs}}ms
+{{- if .Message}}
+원인: {{.Message}}
+{{- end}}
+인시던트 ID: {{.IncidentID}}
+`)),
+	},
+	notification.EventIncidentResolved: {
+		Subject: template.Must(template.New("subject").Parse(`[keeplo] {{.MonitorName}} 정상 복구`)),
+		Body: template.Must(template.New("body").Parse(`모니터링 대상이 정상으로 복구되었습니다.
+
+모니터: {{.MonitorName}} ({{.MonitorType}})
+대상: {{.Target}}
+상태: {{.PreviousStatus}} -> {{.NewStatus}}
+복구 시각: {{.OccurredAt}}
+응답 시간: {{.ResponseMs}}ms
+인시던트 ID: {{.IncidentID}}
+`)),
+	},
+	notification.EventTest: {
+		Subject: template.Must(template.New("subject").Parse(`[keeplo] 알림 테스트`)),
+		Body: template.Must(template.New("body").Parse(`keeplo 알림 채널 테스트 메일입니다.
+이 메일을 받았다면 장애/복구 알림을 정상적으로 받을 수 있습니다.
+
+발송 시각: {{.OccurredAt}}
+`)),
+	},
+}
+
+type emailData struct {
+	MonitorName    string
+	MonitorType    string
+	Target         string
+	PreviousStatus string
+	NewStatus      string
+	IncidentID     string
+	ResponseMs     int
+	Message        string
+	OccurredAt     string
+}
+
+// SMTP 로 장애/복구 메일 전송
+// 수신자는 채널 소유 사용자의 이메일뿐이고, Target 이 다른 주소면 보내지 않음
+type EmailNotifier struct {
+	conf      config.SMTPConfig
+	userRepo  user.Repository
+	templates map[string]EmailTemplate
+}
+
+func NewEmailNotifier(conf config.SMTPConfig, userRepo user.Repository) *EmailNotifier {
+	return &EmailNotifier{
+		conf:      conf,
+		userRepo:  userRepo,
+		templates: defaultEmailTemplates,
+	}
+}
+
+func (e *EmailNotifier) Send(ctx context.Context, ch *notification.Channel, ev *notification.Event) error {
+	to, err := e.recipient(ctx, ch)
+	if err != nil {
+		return err
+	}
+
+	subject, body, err := e.render(ev)
+	if err != nil {
+		return err
+	}
+
+	from, err := mail.ParseAddress(e.conf.From)
+	if err != nil {
+		return fmt.Errorf("invalid sender address: %w", err)
+	}
+
+	return e.sendMail(ctx, from, to, buildMessage(from, to, subject, body))
+}
+
+func (e *EmailNotifier) recipient(ctx context.Context, ch *notification.Channel) (*mail.Address, error) {
+	u, err := e.userRepo.FindByID(ctx, ch.UserID.String())
+	if err != nil {
+		return nil, fmt.Errorf("find channel owner: %w", err)
+	}
+	if u.Email == "" {
+		return nil, errors.New("channel owner has no email")
+	}
+	owner, err := mail.ParseAddress(u.Email)
+	if err != nil {
+		return nil, err
+	}
+	if ch.Target == "" {
+		return owner, nil
+	}
+
+	// 검증 이전에 저장된 채널도 다른 주소로는 보내지 않음
+	to, err := mail.ParseAddress(ch.Target)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(to.Address, owner.Address) {
+		return nil, errors.New("email target is not the channel owner's address")
+	}
+	return to, nil
+}
+
+func (e *EmailNotifier) render(ev *notification.Event) (string, string, error) {
+	tmpl, ok := e.templates[ev.Type]
+	if !ok {
+		return "", "", fmt.Errorf("no email template for event: %s", ev.Type)
+	}
+
+	data := emailData{
+		PreviousStatus: ev.PreviousStatus,
+		NewStatus:      ev.NewStatus,
+		IncidentID:     ev.IncidentID,
+		ResponseMs:     ev.ResponseMs,
+		Message:        ev.Message,
+		OccurredAt:     ev.OccurredAt.Format("2006-01-02 15:04:05 MST"),
+	}
+	if ev.Monitor != nil {
+		data.MonitorName = ev.Monitor.Name
+		data.MonitorType = ev.Monitor.Type
+		data.Target = ev.Monitor.Target
+	}
+
+	var subject, body bytes.Buffer
+	if err := tmpl.Subject.Execute(&subject, data); err != nil {
+		return "", "", fmt.Errorf("render email subject: %w", err)
+	}
+	if err := tmpl.Body.Execute(&body, data); err != nil {
+		return "", "", fmt.Errorf("render email body: %w", err)
+	}
+	return strings.TrimSpace(subject.String()), body.String(), nil
+}
+
+func (e *EmailNotifier) sendMail(ctx context.Context, from, to *mail.Address, msg []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, emailTimeout)
+	defer cancel()
+
+	dialer := net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", e.conf.Addr())
+	if err != nil {
+		return fmt.Errorf("smtp connection failed: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	tlsConf := &tls.Config{ServerName: e.conf.Host}
+	if e.conf.ImplicitTLS {
+		conn = tls.Client(conn, tlsConf)
+	}
+
+	c, err := smtp.NewClient(conn, e.conf.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("smtp handshake failed: %w", err)
+	}
+	defer c.Close()
+
+	if !e.conf.ImplicitTLS {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(tlsConf); err != nil {
+				return fmt.Errorf("smtp starttls failed: %w", err)
+			}
+		}
+	}
+
+	if e.conf.Username != "" {
+		auth := smtp.PlainAuth("", e.conf.Username, e.conf.Password, e.conf.Host)
+		if err := c.Auth(auth); err != nil {
+			return fmt.Errorf("smtp auth failed: %w", err)
+		}
+	}
+
+	if err := c.Mail(from.Address); err != nil {
+		return fmt.Errorf("smtp MAIL FROM failed: %w", err)
+	}
+	if err := c.Rcpt(to.Address); err != nil {
+		return fmt.Errorf("smtp RCPT TO failed: %w", err)
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("smtp DATA failed: %w", err)
+	}
+	if _, err := w.Write(msg); err != nil {
+		return fmt.Errorf("smtp write failed: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("smtp send failed: %w", err)
+	}
+	return c.Quit()
+}
+
+func buildMessage(from, to *mail.Address, subject, body string) []byte {
+	var b bytes.Buffer
+	b.WriteString("From: " + from.String() + "\r\n")
+	b.WriteString("To: " + to.String() + "\r\n")
+	b.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", subject) + "\r\n")
+	b.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(strings.ReplaceAll(body, "\r\n", "\n"), "\n", "\r\n"))
+	return b.Bytes()
+}
@@ -0,0 +1,163 @@
+package notifier
+
+import (
+	"bufio"
+	"context"
+	"keeplo/config"
+	"keeplo/internal/domain/monitor"
+	"keeplo/internal/domain/notification"
+	"keeplo/internal/domain/user"
+	"mime"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeUserRepo struct {
+	user.Repository
+	u *user.User
+}
+
+func (f *fakeUserRepo) FindByID(ctx context.Context, id string) (*user.User, error) {
+	return f.u, nil
+}
+
+type smtpMessage struct {
+	from string
+	to   []string
+	data string
+}
+
+// 최소한의 SMTP 대화만 처리하는 테스트용 서버
+func startFakeSMTP(t *testing.T) (string, <-chan smtpMessage) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	received := make(chan smtpMessage, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+		reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+
+		var msg smtpMessage
+		reply("220 fake smtp")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			cmd := strings.ToUpper(strings.TrimSpace(line))
+			switch {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				reply("250 fake")
+			case strings.HasPrefix(cmd, "MAIL FROM:"):
+				msg.from = strings.TrimSpace(line[len("MAIL FROM:"):])
+				reply("250 ok")
+			case strings.HasPrefix(cmd, "RCPT TO:"):
+				msg.to = append(msg.to, strings.TrimSpace(line[len("RCPT TO:"):]))
+				reply("250 ok")
+			case cmd == "DATA":
+				reply("354 go ahead")
+				var data strings.Builder
+				for {
+					l, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if l == ".\r\n" {
+						break
+					}
+					data.WriteString(l)
+				}
+				msg.data = data.String()
+				reply("250 queued")
+				received <- msg
+			case cmd == "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("502 not implemented")
+			}
+		}
+	}()
+
+	return ln.Addr().String(), received
+}
+
+func TestEmailNotifier_SendToChannelOwner(t *testing.T) {
+	addr, received := startFakeSMTP(t)
+	host, port, err := net.SplitHostPort(addr)
+	require.NoError(t, err)
+
+	owner := &user.User{ID: uuid.New(), Email: "owner@example.com"}
+	n := NewEmailNotifier(config.SMTPConfig{
+		Host: host,
+		Port: port,
+		From: "keeplo <noreply@keeplo.local>",
+	}, &fakeUserRepo{u: owner})
+
+	ev := &notification.Event{
+		Type:           notification.EventIncidentOpened,
+		Monitor:        &monitor.Monitor{ID: uuid.New(), Name: "api", Type: "http", Target: "http://api.example.com:80"},
+		PreviousStatus: "up",
+		NewStatus:      "down",
+		IncidentID:     uuid.NewString(),
+		ResponseMs:     120,
+		Message:        "HTTP status 503",
+		OccurredAt:     time.Now(),
+	}
+	ch := &notification.Channel{ID: uuid.New(), UserID: owner.ID, Type: notification.ChannelEmail}
+
+	require.NoError(t, n.Send(context.Background(), ch, ev))
+
+	select {
+	case msg := <-received:
+		assert.Equal(t, "<noreply@keeplo.local>", msg.from)
+		assert.Equal(t, []string{"<owner@example.com>"}, msg.to)
+
+		subject := ""
+		for _, line := range strings.Split(msg.data, "\r\n") {
+			if strings.HasPrefix(line, "Subject: ") {
+				subject, err = new(mime.WordDecoder).DecodeHeader(strings.TrimPrefix(line, "Subject: "))
+				require.NoError(t, err)
+			}
+		}
+		assert.Equal(t, "[keeplo] api 장애 발생", subject)
+		assert.Contains(t, msg.data, "HTTP status 503")
+		assert.Contains(t, msg.data, ev.IncidentID)
+	case <-time.After(3 * time.Second):
+		t.Fatal("no message received")
+	}
+}
+
+func TestEmailNotifier_UnknownEvent(t *testing.T) {
+	n := NewEmailNotifier(config.SMTPConfig{From: "noreply@keeplo.local"}, &fakeUserRepo{u: &user.User{Email: "ops@example.com"}})
+	ch := &notification.Channel{Type: notification.ChannelEmail, Target: "ops@example.com"}
+
+	err := n.Send(context.Background(), ch, &notification.Event{Type: "unknown"})
+	assert.Error(t, err)
+}
+
+func TestEmailNotifier_RejectsForeignTarget(t *testing.T) {
+	n := NewEmailNotifier(config.SMTPConfig{From: "noreply@keeplo.local"}, &fakeUserRepo{u: &user.User{Email: "owner@example.com"}})
+
+	_, err := n.recipient(context.Background(), &notification.Channel{Type: notification.ChannelEmail, Target: "victim@example.com"})
+	assert.Error(t, err)
+
+	to, err := n.recipient(context.Background(), &notification.Channel{Type: notification.ChannelEmail, Target: "Ops <OWNER@example.com>"})
+	require.NoError(t, err)
+	assert.Equal(t, "OWNER@example.com", to.Address)
+}
@@ -0,0 +1,57 @@
+package notifier
+
+import (
+	"context"
+	"keeplo/internal/domain/notification"
+	"net/http"
+)
+
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type slackBlock struct {
+	Type   string      `json:"type"`
+	Text   *slackText  `json:"text,omitempty"`
+	Fields []slackText `json:"fields,omitempty"`
+}
+
+type slackPayload struct {
+	Text   string       `json:"text"` // 알림 미리보기용
+	Blocks []slackBlock `json:"blocks"`
+}
+
+// Slack incoming webhook (채널 Target 에 webhook URL)
+type SlackNotifier struct {
+	client *http.Client
+}
+
+func NewSlackNotifier() *SlackNotifier {
+	return &SlackNotifier{client: &http.Client{Timeout: chatTimeout}}
+}
+
+func (s *SlackNotifier) Send(ctx context.Context, ch *notification.Channel, ev *notification.Event) error {
+	return postJSON(ctx, s.client, ch.Target, newSlackPayload(ev))
+}
+
+func newSlackPayload(ev *notification.Event) slackPayload {
+	msg := newChatMessage(ev)
+	title := msg.Emoji + " " + msg.Title
+
+	blocks := []slackBlock{
+		{Type: "header", Text: &slackText{Type: "plain_text", Text: title}},
+	}
+
+	fields := make([]slackText, 0, len(msg.Fields))
+	for _, f := range msg.Fields {
+		fields = append(fields, slackText{Type: "mrkdwn", Text: "*" + f[0] + "*\n" + f[1]})
+	}
+	blocks = append(blocks, slackBlock{Type: "section", Fields: fields})
+
+	if msg.Message != "" {
+		blocks = append(blocks, slackBlock{Type: "section", Text: &slackText{Type: "mrkdwn", Text: "*원인*\n```" + msg.Message + "```"}})
+	}
+
+	return slackPayload{Text: title, Blocks: blocks}
+}
@@ -0,0 +1,63 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"html"
+	"keeplo/internal/domain/notification"
+	"net/http"
+	"strings"
+)
+
+const telegramAPIBase = "https://api.telegram.org"
+
+type telegramPayload struct {
+	ChatID    string `json:"chat_id"`
+	Text      string `json:"text"`
+	ParseMode string `json:"parse_mode"`
+}
+
+// Telegram Bot API sendMessage (채널 Target 에 chat ID, Secret 에 봇 토큰)
+type TelegramNotifier struct {
+	client  *http.Client
+	apiBase string
+}
+
+func NewTelegramNotifier() *TelegramNotifier {
+	return &TelegramNotifier{
+		client:  &http.Client{Timeout: chatTimeout},
+		apiBase: telegramAPIBase,
+	}
+}
+
+func (t *TelegramNotifier) Send(ctx context.Context, ch *notification.Channel, ev *notification.Event) error {
+	if ch.Secret == "" || ch.Target == "" {
+		return errors.New("telegram channel requires bot token and chat id")
+	}
+
+	url := t.apiBase + "/bot" + ch.Secret + "/sendMessage"
+	if err := postJSON(ctx, t.client, url, newTelegramPayload(ch.Target, ev)); err != nil {
+		// 요청 URL 에 포함된 봇 토큰이 전송 이력에 남지 않도록 가림
+		return errors.New(strings.ReplaceAll(err.Error(), ch.Secret, "***"))
+	}
+	return nil
+}
+
+func newTelegramPayload(chatID string, ev *notification.Event) telegramPayload {
+	msg := newChatMessage(ev)
+
+	var b strings.Builder
+	b.WriteString(msg.Emoji + " <b>" + html.EscapeString(msg.Title) + "</b>\n")
+	for _, f := range msg.Fields {
+		b.WriteString("\n<b>" + html.EscapeString(f[0]) + "</b>: " + html.EscapeString(f[1]))
+	}
+	if msg.Message != "" {
+		b.WriteString("\n\n<pre>" + html.EscapeString(msg.Message) + "</pre>")
+	}
+
+	return telegramPayload{
+		ChatID:    chatID,
+		Text:      b.String(),
+		ParseMode: "HTML",
+	}
+}
@@ -0,0 +1,120 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"keeplo/internal/domain/notification"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	webhookTimeout = 10 * time.Second
+
+	HeaderEvent     = "X-Keeplo-Event"
+	HeaderTimestamp = "X-Keeplo-Timestamp"
+	HeaderSignature = "X-Keeplo-Signature"
+)
+
+type webhookMonitor struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Target string `json:"target"`
+}
+
+type webhookPayload struct {
+	Event          string         `json:"event"`
+	Monitor        webhookMonitor `json:"monitor"`
+	PreviousStatus string         `json:"previous_status"`
+	NewStatus      string         `json:"new_status"`
+	IncidentID     string         `json:"incident_id,omitempty"`
+	ResponseMs     int            `json:"response_ms"`
+	Message        string         `json:"message,omitempty"`
+	Timestamp      string         `json:"timestamp"`
+}
+
+// 채널 URL 로 JSON 페이로드를 POST
+// 서명: X-Keeplo-Signature = "sha256=" + hex(HMAC-SHA256(채널 secret, "<X-Keeplo-Timestamp>.<body>"))
+type WebhookNotifier struct {
+	client *http.Client
+}
+
+func NewWebhookNotifier() *WebhookNotifier {
+	return &WebhookNotifier{
+		client: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+func (w *WebhookNotifier) Send(ctx context.Context, ch *notification.Channel, ev *notification.Event) error {
+	if ch.Secret == "" {
+		return errors.New("webhook channel has no signing secret")
+	}
+
+	body, err := json.Marshal(newWebhookPayload(ev))
+	if err != nil {
+		return fmt.Errorf("marshal webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ch.Target, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("invalid webhook request: %w", err)
+	}
+
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "keeplo-webhook")
+	req.Header.Set(HeaderEvent, ev.Type)
+	req.Header.Set(HeaderTimestamp, ts)
+	req.Header.Set(HeaderSignature, "sha256="+Sign(ch.Secret, ts, body))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// 수신 측 검증에도 같은 방식 사용
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func newWebhookPayload(ev *notification.Event) webhookPayload {
+	p := webhookPayload{
+		Event:          ev.Type,
+		PreviousStatus: ev.PreviousStatus,
+		NewStatus:      ev.NewStatus,
+		IncidentID:     ev.IncidentID,
+		ResponseMs:     ev.ResponseMs,
+		Message:        ev.Message,
+		Timestamp:      ev.OccurredAt.Format(time.RFC3339),
+	}
+	if ev.Monitor != nil {
+		p.Monitor = webhookMonitor{
+			ID:     ev.Monitor.ID.String(),
+			Name:   ev.Monitor.Name,
+			Type:   ev.Monitor.Type,
+			Target: ev.Monitor.Target,
+		}
+	}
+	return p
+}
@@ -0,0 +1,42 @@
+package notifier
+
+import (
+	"context"
+	"io"
+	"keeplo/internal/domain/notification"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookNotifier_Signs(t *testing.T) {
+	got := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		got <- r
+		bodies <- raw
+	}))
+	t.Cleanup(srv.Close)
+
+	ch := &notification.Channel{Type: notification.ChannelWebhook, Target: srv.URL, Secret: "s3cret"}
+	require.NoError(t, NewWebhookNotifier().Send(context.Background(), ch, newTestEvent(notification.EventIncidentOpened)))
+
+	r, body := <-got, <-bodies
+	assert.Equal(t, notification.EventIncidentOpened, r.Header.Get(HeaderEvent))
+	assert.Equal(t, "sha256="+Sign("s3cret", r.Header.Get(HeaderTimestamp), body), r.Header.Get(HeaderSignature))
+}
+
+func TestWebhookNotifier_RequiresSecret(t *testing.T) {
+	called := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { called = true }))
+	t.Cleanup(srv.Close)
+
+	ch := &notification.Channel{Type: notification.ChannelWebhook, Target: srv.URL}
+	err := NewWebhookNotifier().Send(context.Background(), ch, newTestEvent(notification.EventIncidentOpened))
+	assert.Error(t, err)
+	assert.False(t, called, "unsigned payload must not be sent")
+}
@@ -0,0 +1,111 @@
+package incident_repo
+
+import (
+	"context"
+	"errors"
+	"keeplo/internal/domain/incident"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// PostgreSQL unique_violation
+const uniqueViolation = "23505"
+
+// 모니터마다 복구되지 않은 인시던트는 하나만 허용 (부분 유니크 인덱스)
+type IncidentGorm struct {
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey"`
+	MonitorID      uuid.UUID `gorm:"type:uuid;not null;index;uniqueIndex:idx_incidents_active_monitor,where:status <> 'resolved'"`
+	Status         string    `gorm:"not null;index"`
+	Cause          string
+	HealthLogIDs   []string  `gorm:"type:jsonb;serializer:json"`
+	StartedAt      time.Time `gorm:"not null"`
+	AcknowledgedAt *time.Time
+	ResolvedAt     *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (IncidentGorm) TableName() string {
+	return "incidents"
+}
+
+type GormIncidentRepo struct {
+	db *gorm.DB
+}
+
+func NewGormIncidentRepo(db *gorm.DB) incident.Repository {
+	return &GormIncidentRepo{db: db}
+}
+
+// 같은 모니터에 이미 열린 인시던트가 있으면 ErrIncidentAlreadyOpen
+func (r *GormIncidentRepo) Create(ctx context.Context, i *incident.Incident) error {
+	err := r.db.WithContext(ctx).Create(toGorm(i)).Error
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return incident.ErrIncidentAlreadyOpen
+	}
+	return err
+}
+
+func (r *GormIncidentRepo) Update(ctx context.Context, i *incident.Incident) error {
+	return r.db.WithContext(ctx).
+		Model(&IncidentGorm{}).
+		Where("id = ?", i.ID).
+		Select("status", "acknowledged_at", "resolved_at", "updated_at").
+		Updates(toGorm(i)).Error
+}
+
+func (r *GormIncidentRepo) FindByID(ctx context.Context, id string) (*incident.Incident, error) {
+	var g IncidentGorm
+	if err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&g).Error; err != nil {
+		return nil, err
+	}
+	return toEntity(&g), nil
+}
+
+// 복구되지 않은 가장 최근 인시던트 조회
+func (r *GormIncidentRepo) FindActiveByMonitorID(ctx context.Context, monitorID string) (*incident.Incident, error) {
+	var g IncidentGorm
+	if err := r.db.WithContext(ctx).
+		Where("monitor_id = ? AND status <> ?", monitorID, incident.StatusResolved).
+		Order("started_at DESC").
+		First(&g).Error; err != nil {
+		return nil, err
+	}
+	return toEntity(&g), nil
+}
+
+func toEntity(i *IncidentGorm) *incident.Incident {
+	return &incident.Incident{
+		ID:             i.ID,
+		MonitorID:      i.MonitorID,
+		Status:         i.Status,
+		Cause:          i.Cause,
+		HealthLogIDs:   i.HealthLogIDs,
+		StartedAt:      i.StartedAt,
+		AcknowledgedAt: i.AcknowledgedAt,
+		ResolvedAt:     i.ResolvedAt,
+		CreatedAt:      i.CreatedAt,
+		UpdatedAt:      i.UpdatedAt,
+	}
+}
+
+func toGorm(i *incident.Incident) *IncidentGorm {
+	return &IncidentGorm{
+		ID:             i.ID,
+		MonitorID:      i.MonitorID,
+		Status:         i.Status,
+		Cause:          i.Cause,
+		HealthLogIDs:   i.HealthLogIDs,
+		StartedAt:      i.StartedAt,
+		AcknowledgedAt: i.AcknowledgedAt,
+		ResolvedAt:     i.ResolvedAt,
+		CreatedAt:      i.CreatedAt,
+		UpdatedAt:      i.UpdatedAt,
+	}
+}
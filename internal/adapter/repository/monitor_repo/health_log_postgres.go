@@ -0,0 +1,137 @@
+package monitor_repo
+
+import (
+	"context"
+	"keeplo/internal/domain/monitor"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type HealthLogGorm struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey"`
+	MonitorID  uuid.UUID `gorm:"type:uuid;not null;index:idx_health_logs_monitor_time,priority:1"`
+	Status     string    `gorm:"not null"`
+	Message    string
+	ResponseMs int       `gorm:"not null;default:0"`
+	Timestamp  time.Time `gorm:"not null;index:idx_health_logs_monitor_time,priority:2,sort:desc"`
+
+	Timing *monitor.CheckTiming `gorm:"type:jsonb;serializer:json"`
+}
+
+func (HealthLogGorm) TableName() string {
+	return "health_logs"
+}
+
+type GormHealthLogRepo struct {
+	db *gorm.DB
+}
+
+func NewGormHealthLogRepo(db *gorm.DB) monitor.HealthLogRepository {
+	return &GormHealthLogRepo{db: db}
+}
+
+func (r *GormHealthLogRepo) Create(ctx context.Context, l *monitor.HealthLog) error {
+	g, err := toHealthLogGorm(l)
+	if err != nil {
+		return err
+	}
+	if err := r.db.WithContext(ctx).Create(g).Error; err != nil {
+		return err
+	}
+	l.ID = g.ID.String()
+	return nil
+}
+
+func (r *GormHealthLogRepo) Find(ctx context.Context, q monitor.HealthLogQuery) ([]*monitor.HealthLog, error) {
+	if len(q.MonitorIDs) == 0 {
+		return []*monitor.HealthLog{}, nil
+	}
+
+	tx := r.db.WithContext(ctx).Where("monitor_id IN ?", q.MonitorIDs)
+	if q.Status != "" {
+		tx = tx.Where("status = ?", q.Status)
+	}
+	if q.From != nil {
+		tx = tx.Where("timestamp >= ?", *q.From)
+	}
+	if q.To != nil {
+		tx = tx.Where("timestamp < ?", *q.To)
+	}
+	if q.After != nil {
+		tx = tx.Where("(timestamp, id) < (?, ?)", q.After.Timestamp, q.After.ID)
+	}
+
+	var results []HealthLogGorm
+	if err := tx.
+		Order("timestamp DESC, id DESC").
+		Limit(q.Limit).
+		Find(&results).Error; err != nil {
+		return nil, err
+	}
+
+	list := make([]*monitor.HealthLog, 0, len(results))
+	for _, g := range results {
+		list = append(list, toHealthLogEntity(&g))
+	}
+	return list, nil
+}
+
+// 모니터별 가장 최근 헬스 로그 1건씩 조회
+func (r *GormHealthLogRepo) FindLatestByMonitorIDs(ctx context.Context, monitorIDs []string) ([]*monitor.HealthLog, error) {
+	if len(monitorIDs) == 0 {
+		return []*monitor.HealthLog{}, nil
+	}
+
+	var results []HealthLogGorm
+	if err := r.db.WithContext(ctx).
+		Select("DISTINCT ON (monitor_id) *").
+		Where("monitor_id IN ?", monitorIDs).
+		Order("monitor_id, timestamp DESC").
+		Find(&results).Error; err != nil {
+		return nil, err
+	}
+
+	list := make([]*monitor.HealthLog, 0, len(results))
+	for _, g := range results {
+		list = append(list, toHealthLogEntity(&g))
+	}
+	return list, nil
+}
+
+func toHealthLogEntity(l *HealthLogGorm) *monitor.HealthLog {
+	return &monitor.HealthLog{
+		ID:         l.ID.String(),
+		MonitorID:  l.MonitorID.String(),
+		Status:     l.Status,
+		Message:    l.Message,
+		ResponseMs: l.ResponseMs,
+		Timestamp:  l.Timestamp,
+		Timing:     l.Timing,
+	}
+}
+
+func toHealthLogGorm(l *monitor.HealthLog) (*HealthLogGorm, error) {
+	monitorID, err := uuid.Parse(l.MonitorID)
+	if err != nil {
+		return nil, err
+	}
+
+	id := uuid.New()
+	if l.ID != "" {
+		if id, err = uuid.Parse(l.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &HealthLogGorm{
+		ID:         id,
+		MonitorID:  monitorID,
+		Status:     l.Status,
+		Message:    l.Message,
+		ResponseMs: l.ResponseMs,
+		Timestamp:  l.Timestamp,
+		Timing:     l.Timing,
+	}, nil
+}
@@ -3,36 +3,54 @@ package monitor_repo
 import (
 	"context"
 	"keeplo/internal/domain/monitor"
+	"keeplo/pkg/logger"
+	"keeplo/pkg/secret"
 	"time"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
 
 type MonitorGorm struct {
-	ID              uuid.UUID `gorm:"type:uuid;primaryKey"`
-	UserID          uuid.UUID `gorm:"type:uuid;not null;index"`
-	Name            string    `gorm:"not null"`
-	Target          string    `gorm:"not null"`
-	Type            string    `gorm:"not null"`
-	IntervalSeconds int       `gorm:"not null;default:60"`
-	Enabled         bool      `gorm:"default:true"`
-	LastCheckedAt   *time.Time
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
-	IsDeleted       bool
+	ID                uuid.UUID        `gorm:"type:uuid;primaryKey"`
+	UserID            uuid.UUID        `gorm:"type:uuid;not null;index"`
+	Name              string           `gorm:"not null"`
+	Target            string           `gorm:"not null"`
+	Type              string           `gorm:"not null"`
+	IntervalSeconds   int              `gorm:"not null;default:60"`
+	Enabled           bool             `gorm:"default:true"`
+	FailureThreshold  int              `gorm:"not null;default:3"`
+	RecoveryThreshold int              `gorm:"not null;default:2"`
+	TimeoutSeconds    int              `gorm:"not null;default:0"`
+	DegradedMs        int              `gorm:"not null;default:0"`
+	Settings          monitor.Settings `gorm:"type:jsonb;serializer:json"`
+	PingToken         *string          `gorm:"uniqueIndex"`
+	LastPingAt        *time.Time
+	RunStartedAt      *time.Time
+	LastCheckedAt     *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	IsDeleted         bool
 }
 
 type GormMonitorRepo struct {
-	db *gorm.DB
+	db  *gorm.DB
+	box *secret.Box // 설정 안의 인증 정보 암복호화
 }
 
-func NewGormMonitorRepo(db *gorm.DB) monitor.Repository {
-	return &GormMonitorRepo{db: db}
+func NewGormMonitorRepo(db *gorm.DB, box *secret.Box) monitor.Repository {
+	return &GormMonitorRepo{db: db, box: box}
 }
 
 func (r *GormMonitorRepo) Create(ctx context.Context, m *monitor.Monitor) error {
-	return r.db.WithContext(ctx).Create(toGorm(m)).Error
+	g := toGorm(m)
+	settings, err := sealSettings(r.box, m.Settings)
+	if err != nil {
+		return err
+	}
+	g.Settings = settings
+	return r.db.WithContext(ctx).Create(g).Error
 }
 
 func (r *GormMonitorRepo) FindByUserID(ctx context.Context, userID string) ([]*monitor.Monitor, error) {
@@ -43,11 +61,7 @@ func (r *GormMonitorRepo) FindByUserID(ctx context.Context, userID string) ([]*m
 		return nil, err
 	}
 
-	var list []*monitor.Monitor
-	for _, g := range results {
-		list = append(list, toEntity(&g))
-	}
-	return list, nil
+	return r.toEntities(ctx, results), nil
 }
 
 func (r *GormMonitorRepo) FindByID(ctx context.Context, id string) (*monitor.Monitor, error) {
@@ -57,7 +71,29 @@ func (r *GormMonitorRepo) FindByID(ctx context.Context, id string) (*monitor.Mon
 		First(&g).Error; err != nil {
 		return nil, err
 	}
-	return toEntity(&g), nil
+	return r.toEntity(&g)
+}
+
+func (r *GormMonitorRepo) FindByPingToken(ctx context.Context, token string) (*monitor.Monitor, error) {
+	var g MonitorGorm
+	if err := r.db.WithContext(ctx).
+		Where("ping_token = ? AND is_deleted = false", token).
+		First(&g).Error; err != nil {
+		return nil, err
+	}
+	return r.toEntity(&g)
+}
+
+// 삭제되지 않은 활성 모니터 전체 조회 (스케줄러 복구용)
+func (r *GormMonitorRepo) FindAllEnabled(ctx context.Context) ([]*monitor.Monitor, error) {
+	var results []MonitorGorm
+	if err := r.db.WithContext(ctx).
+		Where("enabled = true AND is_deleted = false").
+		Find(&results).Error; err != nil {
+		return nil, err
+	}
+
+	return r.toEntities(ctx, results), nil
 }
 
 func (r *GormMonitorRepo) SoftDelete(ctx context.Context, id string) error {
@@ -74,45 +110,126 @@ func (r *GormMonitorRepo) HardDelete(ctx context.Context, id string) error {
 }
 
 func (r *GormMonitorRepo) Update(ctx context.Context, m *monitor.Monitor) error {
+	settings, err := sealSettings(r.box, m.Settings)
+	if err != nil {
+		return err
+	}
+
 	return r.db.WithContext(ctx).
 		Model(&MonitorGorm{}).
 		Where("id = ?", m.ID).
+		Select("name", "type", "target", "interval_seconds", "enabled", "failure_threshold", "recovery_threshold", "timeout_seconds", "degraded_ms", "settings", "ping_token", "updated_at").
 		Updates(MonitorGorm{
-			Name:            m.Name,
-			Type:            m.Type,
-			Target:          m.Target,
-			IntervalSeconds: m.IntervalSeconds,
-			UpdatedAt:       m.UpdatedAt,
+			Name:              m.Name,
+			Type:              m.Type,
+			Target:            m.Target,
+			IntervalSeconds:   m.IntervalSeconds,
+			Enabled:           m.Enabled,
+			FailureThreshold:  m.FailureThreshold,
+			RecoveryThreshold: m.RecoveryThreshold,
+			TimeoutSeconds:    m.TimeoutSeconds,
+			DegradedMs:        m.DegradedMs,
+			Settings:          settings,
+			PingToken:         toGorm(m).PingToken,
+			UpdatedAt:         m.UpdatedAt,
+		}).Error
+}
+
+func (r *GormMonitorRepo) UpdateLastCheckedAt(ctx context.Context, id string, checkedAt time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&MonitorGorm{}).
+		Where("id = ?", id).
+		Update("last_checked_at", checkedAt).Error
+}
+
+// 조회 결과를 도메인 모델로 변환하면서 인증 정보를 복호화
+func (r *GormMonitorRepo) toEntity(g *MonitorGorm) (*monitor.Monitor, error) {
+	m := toEntity(g)
+	settings, err := openSettings(r.box, g.Settings)
+	if err != nil {
+		return nil, err
+	}
+	m.Settings = settings
+	return m, nil
+}
+
+// 목록 조회 결과 변환. 키 교체나 손상으로 인증 정보를 복호화할 수 없는 모니터는
+// 로그를 남기고 건너뛰어서 나머지 모니터의 조회와 스케줄 복구는 계속되게 함
+func (r *GormMonitorRepo) toEntities(ctx context.Context, results []MonitorGorm) []*monitor.Monitor {
+	list := make([]*monitor.Monitor, 0, len(results))
+	for _, g := range results {
+		m, err := r.toEntity(&g)
+		if err != nil {
+			logger.WithContext(ctx).Error("GormMonitorRepo - open settings failed", zap.String("monitor_id", g.ID.String()), zap.Error(err))
+			continue
+		}
+		list = append(list, m)
+	}
+	return list
+}
+
+// 하트비트 핑 상태 갱신. nil 이면 컬럼을 비움
+func (r *GormMonitorRepo) UpdatePingState(ctx context.Context, id string, lastPingAt, runStartedAt *time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&MonitorGorm{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"last_ping_at":   lastPingAt,
+			"run_started_at": runStartedAt,
 		}).Error
 }
 
 func toEntity(m *MonitorGorm) *monitor.Monitor {
+	var pingToken string
+	if m.PingToken != nil {
+		pingToken = *m.PingToken
+	}
 	return &monitor.Monitor{
-		ID:              m.ID,
-		UserID:          m.UserID,
-		Name:            m.Name,
-		Target:          m.Target,
-		Type:            m.Type,
-		IntervalSeconds: m.IntervalSeconds,
-		Enabled:         m.Enabled,
-		LastCheckedAt:   m.LastCheckedAt,
-		CreatedAt:       m.CreatedAt,
-		UpdatedAt:       m.UpdatedAt,
+		ID:                m.ID,
+		UserID:            m.UserID,
+		Name:              m.Name,
+		Target:            m.Target,
+		Type:              m.Type,
+		IntervalSeconds:   m.IntervalSeconds,
+		Enabled:           m.Enabled,
+		FailureThreshold:  m.FailureThreshold,
+		RecoveryThreshold: m.RecoveryThreshold,
+		TimeoutSeconds:    m.TimeoutSeconds,
+		DegradedMs:        m.DegradedMs,
+		Settings:          m.Settings,
+		PingToken:         pingToken,
+		LastPingAt:        m.LastPingAt,
+		RunStartedAt:      m.RunStartedAt,
+		LastCheckedAt:     m.LastCheckedAt,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
 	}
 }
 
 func toGorm(m *monitor.Monitor) *MonitorGorm {
+	var pingToken *string
+	if m.PingToken != "" {
+		pingToken = &m.PingToken
+	}
 	return &MonitorGorm{
-		ID:              m.ID,
-		UserID:          m.UserID,
-		Name:            m.Name,
-		Target:          m.Target,
-		Type:            m.Type,
-		IntervalSeconds: m.IntervalSeconds,
-		Enabled:         m.Enabled,
-		LastCheckedAt:   m.LastCheckedAt,
-		CreatedAt:       m.CreatedAt,
-		UpdatedAt:       m.UpdatedAt,
-		IsDeleted:       false,
+		ID:                m.ID,
+		UserID:            m.UserID,
+		Name:              m.Name,
+		Target:            m.Target,
+		Type:              m.Type,
+		IntervalSeconds:   m.IntervalSeconds,
+		Enabled:           m.Enabled,
+		FailureThreshold:  m.FailureThreshold,
+		RecoveryThreshold: m.RecoveryThreshold,
+		TimeoutSeconds:    m.TimeoutSeconds,
+		DegradedMs:        m.DegradedMs,
+		Settings:          m.Settings,
+		PingToken:         pingToken,
+		LastPingAt:        m.LastPingAt,
+		RunStartedAt:      m.RunStartedAt,
+		LastCheckedAt:     m.LastCheckedAt,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
+		IsDeleted:         false,
 	}
 }
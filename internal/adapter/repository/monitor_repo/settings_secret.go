@@ -0,0 +1,46 @@
+package monitor_repo
+
+import (
+	"fmt"
+	"keeplo/internal/domain/monitor"
+	"keeplo/pkg/checker"
+	"keeplo/pkg/secret"
+)
+
+// 설정 안의 인증 정보를 암호화한 사본을 반환 (원본은 변경하지 않음)
+func sealSettings(box *secret.Box, s monitor.Settings) (monitor.Settings, error) {
+	out, err := mapSecrets(s, box.Seal)
+	if err != nil {
+		return s, fmt.Errorf("seal monitor settings: %w", err)
+	}
+	return out, nil
+}
+
+func openSettings(box *secret.Box, s monitor.Settings) (monitor.Settings, error) {
+	out, err := mapSecrets(s, box.Open)
+	if err != nil {
+		return s, fmt.Errorf("open monitor settings: %w", err)
+	}
+	return out, nil
+}
+
+// 블록마다 checker 정의가 선언한 인증 정보 필드에만 fn 을 적용. 원본 맵은 변경하지 않음
+func mapSecrets(s monitor.Settings, fn func(string) (string, error)) (monitor.Settings, error) {
+	if s == nil {
+		return nil, nil
+	}
+	out := make(monitor.Settings, len(s))
+	for key, raw := range s {
+		def, ok := checker.LookupSettings(key)
+		if !ok {
+			out[key] = raw
+			continue
+		}
+		block, err := def.MapSecrets(raw, fn)
+		if err != nil {
+			return s, fmt.Errorf("%s: %w", key, err)
+		}
+		out[key] = block
+	}
+	return out, nil
+}
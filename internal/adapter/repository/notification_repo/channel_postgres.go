@@ -0,0 +1,209 @@
+package notification_repo
+
+import (
+	"context"
+	"fmt"
+	"keeplo/internal/domain/notification"
+	"keeplo/pkg/secret"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ChannelGorm struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;index"`
+	Name      string    `gorm:"not null"`
+	Type      string    `gorm:"not null"`
+	Target    string    `gorm:"not null"`
+	Secret    string    // 암호화해서 저장
+	Enabled   bool      `gorm:"default:true"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (ChannelGorm) TableName() string {
+	return "notification_channels"
+}
+
+// 모니터와 알림 채널 연결
+type MonitorChannelGorm struct {
+	MonitorID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	ChannelID uuid.UUID `gorm:"type:uuid;primaryKey;index"`
+	CreatedAt time.Time
+}
+
+func (MonitorChannelGorm) TableName() string {
+	return "monitor_channels"
+}
+
+type GormChannelRepo struct {
+	db  *gorm.DB
+	box *secret.Box // webhook 서명 키, telegram 봇 토큰 암복호화
+}
+
+func NewGormChannelRepo(db *gorm.DB, box *secret.Box) notification.ChannelRepository {
+	return &GormChannelRepo{db: db, box: box}
+}
+
+func (r *GormChannelRepo) Create(ctx context.Context, ch *notification.Channel) error {
+	g, err := r.toGorm(ch)
+	if err != nil {
+		return err
+	}
+	return r.db.WithContext(ctx).Create(g).Error
+}
+
+func (r *GormChannelRepo) Update(ctx context.Context, ch *notification.Channel) error {
+	g, err := r.toGorm(ch)
+	if err != nil {
+		return err
+	}
+	return r.db.WithContext(ctx).
+		Model(&ChannelGorm{}).
+		Where("id = ?", ch.ID).
+		Select("name", "target", "secret", "enabled", "updated_at").
+		Updates(g).Error
+}
+
+// 채널과 모니터 연결을 함께 삭제
+func (r *GormChannelRepo) Delete(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("channel_id = ?", id).Delete(&MonitorChannelGorm{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", id).Delete(&ChannelGorm{}).Error
+	})
+}
+
+func (r *GormChannelRepo) FindByID(ctx context.Context, id string) (*notification.Channel, error) {
+	var g ChannelGorm
+	if err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&g).Error; err != nil {
+		return nil, err
+	}
+	return r.toEntity(&g)
+}
+
+func (r *GormChannelRepo) FindByUserID(ctx context.Context, userID string) ([]*notification.Channel, error) {
+	var results []ChannelGorm
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at").
+		Find(&results).Error; err != nil {
+		return nil, err
+	}
+
+	return r.toEntities(results)
+}
+
+// 모니터에 연결된 활성 채널 조회
+func (r *GormChannelRepo) FindByMonitorID(ctx context.Context, monitorID string) ([]*notification.Channel, error) {
+	var results []ChannelGorm
+	if err := r.db.WithContext(ctx).
+		Joins("JOIN monitor_channels ON monitor_channels.channel_id = notification_channels.id").
+		Where("monitor_channels.monitor_id = ? AND notification_channels.enabled = true", monitorID).
+		Find(&results).Error; err != nil {
+		return nil, err
+	}
+
+	return r.toEntities(results)
+}
+
+// 이미 연결되어 있으면 무시
+func (r *GormChannelRepo) AttachMonitor(ctx context.Context, channelID, monitorID string) error {
+	chID, err := uuid.Parse(channelID)
+	if err != nil {
+		return err
+	}
+	mID, err := uuid.Parse(monitorID)
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&MonitorChannelGorm{MonitorID: mID, ChannelID: chID, CreatedAt: time.Now()}).Error
+}
+
+func (r *GormChannelRepo) DetachMonitor(ctx context.Context, channelID, monitorID string) error {
+	return r.db.WithContext(ctx).
+		Where("channel_id = ? AND monitor_id = ?", channelID, monitorID).
+		Delete(&MonitorChannelGorm{}).Error
+}
+
+func (r *GormChannelRepo) FindMonitorIDs(ctx context.Context, channelID string) ([]string, error) {
+	var ids []string
+	if err := r.db.WithContext(ctx).
+		Model(&MonitorChannelGorm{}).
+		Where("channel_id = ?", channelID).
+		Order("created_at").
+		Pluck("monitor_id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *GormChannelRepo) toEntities(results []ChannelGorm) ([]*notification.Channel, error) {
+	list := make([]*notification.Channel, 0, len(results))
+	for _, g := range results {
+		ch, err := r.toEntity(&g)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, ch)
+	}
+	return list, nil
+}
+
+// 조회 결과를 도메인 모델로 변환하면서 Secret 을 복호화
+func (r *GormChannelRepo) toEntity(g *ChannelGorm) (*notification.Channel, error) {
+	ch := toChannelEntity(g)
+	plain, err := r.box.Open(g.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("open channel secret: %w", err)
+	}
+	ch.Secret = plain
+	return ch, nil
+}
+
+func (r *GormChannelRepo) toGorm(ch *notification.Channel) (*ChannelGorm, error) {
+	g := toChannelGorm(ch)
+	sealed, err := r.box.Seal(ch.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("seal channel secret: %w", err)
+	}
+	g.Secret = sealed
+	return g, nil
+}
+
+func toChannelEntity(c *ChannelGorm) *notification.Channel {
+	return &notification.Channel{
+		ID:        c.ID,
+		UserID:    c.UserID,
+		Name:      c.Name,
+		Type:      c.Type,
+		Target:    c.Target,
+		Secret:    c.Secret,
+		Enabled:   c.Enabled,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+func toChannelGorm(c *notification.Channel) *ChannelGorm {
+	return &ChannelGorm{
+		ID:        c.ID,
+		UserID:    c.UserID,
+		Name:      c.Name,
+		Type:      c.Type,
+		Target:    c.Target,
+		Secret:    c.Secret,
+		Enabled:   c.Enabled,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
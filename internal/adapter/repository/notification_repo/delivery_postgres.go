@@ -0,0 +1,83 @@
+package notification_repo
+
+import (
+	"context"
+	"keeplo/internal/domain/notification"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type DeliveryGorm struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey"`
+	ChannelID  uuid.UUID `gorm:"type:uuid;not null;index"`
+	MonitorID  uuid.UUID `gorm:"type:uuid;not null;index"`
+	IncidentID string
+	Event      string `gorm:"not null"`
+	Status     string `gorm:"not null"`
+	Attempts   int    `gorm:"not null;default:0"`
+	Error      string
+	CreatedAt  time.Time `gorm:"index"`
+}
+
+func (DeliveryGorm) TableName() string {
+	return "notification_deliveries"
+}
+
+type GormDeliveryRepo struct {
+	db *gorm.DB
+}
+
+func NewGormDeliveryRepo(db *gorm.DB) notification.DeliveryRepository {
+	return &GormDeliveryRepo{db: db}
+}
+
+func (r *GormDeliveryRepo) Create(ctx context.Context, d *notification.Delivery) error {
+	return r.db.WithContext(ctx).Create(toDeliveryGorm(d)).Error
+}
+
+func (r *GormDeliveryRepo) FindByMonitorID(ctx context.Context, monitorID string, limit int) ([]*notification.Delivery, error) {
+	var results []DeliveryGorm
+	if err := r.db.WithContext(ctx).
+		Where("monitor_id = ?", monitorID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&results).Error; err != nil {
+		return nil, err
+	}
+
+	list := make([]*notification.Delivery, 0, len(results))
+	for _, g := range results {
+		list = append(list, toDeliveryEntity(&g))
+	}
+	return list, nil
+}
+
+func toDeliveryEntity(d *DeliveryGorm) *notification.Delivery {
+	return &notification.Delivery{
+		ID:         d.ID,
+		ChannelID:  d.ChannelID,
+		MonitorID:  d.MonitorID,
+		IncidentID: d.IncidentID,
+		Event:      d.Event,
+		Status:     d.Status,
+		Attempts:   d.Attempts,
+		Error:      d.Error,
+		CreatedAt:  d.CreatedAt,
+	}
+}
+
+func toDeliveryGorm(d *notification.Delivery) *DeliveryGorm {
+	return &DeliveryGorm{
+		ID:         d.ID,
+		ChannelID:  d.ChannelID,
+		MonitorID:  d.MonitorID,
+		IncidentID: d.IncidentID,
+		Event:      d.Event,
+		Status:     d.Status,
+		Attempts:   d.Attempts,
+		Error:      d.Error,
+		CreatedAt:  d.CreatedAt,
+	}
+}
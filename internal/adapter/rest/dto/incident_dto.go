@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"keeplo/internal/domain/incident"
+	"time"
+)
+
+// Response --------------------------------------
+
+type IncidentResponse struct {
+	ID             string   `json:"id"`
+	MonitorID      string   `json:"monitor_id"`
+	Status         string   `json:"status"` // "opened" | "acknowledged" | "resolved"
+	Cause          string   `json:"cause,omitempty"`
+	HealthLogIDs   []string `json:"health_log_ids"`
+	StartedAt      string   `json:"started_at"`
+	AcknowledgedAt string   `json:"acknowledged_at,omitempty"`
+	ResolvedAt     string   `json:"resolved_at,omitempty"`
+}
+
+func ToIncidentResponse(i *incident.Incident) IncidentResponse {
+	res := IncidentResponse{
+		ID:           i.ID.String(),
+		MonitorID:    i.MonitorID.String(),
+		Status:       i.Status,
+		Cause:        i.Cause,
+		HealthLogIDs: i.HealthLogIDs,
+		StartedAt:    i.StartedAt.Format(time.RFC3339),
+	}
+	if i.AcknowledgedAt != nil {
+		res.AcknowledgedAt = i.AcknowledgedAt.Format(time.RFC3339)
+	}
+	if i.ResolvedAt != nil {
+		res.ResolvedAt = i.ResolvedAt.Format(time.RFC3339)
+	}
+	return res
+}
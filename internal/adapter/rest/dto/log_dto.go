@@ -1 +1,114 @@
 package dto
+
+import (
+	"keeplo/internal/domain/monitor"
+	"time"
+)
+
+// Request --------------------------------------
+
+type HealthLogQueryRequest struct {
+	Cursor string     `form:"cursor"`                                            // 이전 응답의 next_cursor
+	Limit  int        `form:"limit" binding:"omitempty,min=1,max=200"`           // 기본 50
+	Status string     `form:"status" binding:"omitempty,oneof=up degraded down"` // 상태 필터
+	From   *time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`      // RFC3339, 포함
+	To     *time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`        // RFC3339, 미포함
+}
+
+// Response --------------------------------------
+
+type HealthLogResponse struct {
+	ID         string `json:"id"`
+	MonitorID  string `json:"monitor_id"`
+	Status     string `json:"status"`
+	Message    string `json:"message,omitempty"`
+	ResponseMs int    `json:"response_ms"`
+	Timestamp  string `json:"timestamp"`
+
+	Timing *TimingResponse `json:"timing,omitempty"` // HTTP, TLS 체크만
+}
+
+// 응답 시간 구간별 소요 시간 (ms)
+type TimingResponse struct {
+	DNSMs       float64 `json:"dns_ms"`
+	ConnectMs   float64 `json:"connect_ms"`
+	TLSMs       float64 `json:"tls_ms"`
+	FirstByteMs float64 `json:"first_byte_ms"`
+	TransferMs  float64 `json:"transfer_ms"`
+	RemoteIP    string  `json:"remote_ip,omitempty"`
+	StatusCode  int     `json:"status_code,omitempty"`
+	SizeBytes   int64   `json:"size_bytes,omitempty"`
+}
+
+type HealthLogListResponse struct {
+	Items      []HealthLogResponse `json:"items"`
+	NextCursor string              `json:"next_cursor,omitempty"`
+}
+
+type MonitorStatusResponse struct {
+	MonitorID     string `json:"monitor_id"`
+	Name          string `json:"name"`
+	Enabled       bool   `json:"enabled"`
+	Status        string `json:"status"` // 체크 이력이 없으면 "unknown"
+	Message       string `json:"message,omitempty"`
+	ResponseMs    int    `json:"response_ms"`
+	LastCheckedAt string `json:"last_checked_at,omitempty"`
+
+	Timing *TimingResponse `json:"timing,omitempty"`
+}
+
+func ToHealthLogResponse(l *monitor.HealthLog) HealthLogResponse {
+	return HealthLogResponse{
+		ID:         l.ID,
+		MonitorID:  l.MonitorID,
+		Status:     l.Status,
+		Message:    l.Message,
+		ResponseMs: l.ResponseMs,
+		Timestamp:  l.Timestamp.Format(time.RFC3339),
+		Timing:     toTimingResponse(l.Timing),
+	}
+}
+
+func toTimingResponse(t *monitor.CheckTiming) *TimingResponse {
+	if t == nil {
+		return nil
+	}
+	return &TimingResponse{
+		DNSMs:       t.DNSMs,
+		ConnectMs:   t.ConnectMs,
+		TLSMs:       t.TLSMs,
+		FirstByteMs: t.FirstByteMs,
+		TransferMs:  t.TransferMs,
+		RemoteIP:    t.RemoteIP,
+		StatusCode:  t.StatusCode,
+		SizeBytes:   t.SizeBytes,
+	}
+}
+
+func NewHealthLogListResponse(logs []*monitor.HealthLog, nextCursor string) HealthLogListResponse {
+	items := make([]HealthLogResponse, 0, len(logs))
+	for _, l := range logs {
+		items = append(items, ToHealthLogResponse(l))
+	}
+	return HealthLogListResponse{
+		Items:      items,
+		NextCursor: nextCursor,
+	}
+}
+
+func ToMonitorStatusResponse(s *monitor.MonitorStatus) MonitorStatusResponse {
+	res := MonitorStatusResponse{
+		MonitorID: s.Monitor.ID.String(),
+		Name:      s.Monitor.Name,
+		Enabled:   s.Monitor.Enabled,
+		Status:    "unknown",
+	}
+	if s.LastLog != nil {
+		res.Status = s.LastLog.Status
+		res.Message = s.LastLog.Message
+		res.ResponseMs = s.LastLog.ResponseMs
+		res.LastCheckedAt = s.LastLog.Timestamp.Format(time.RFC3339)
+		res.Timing = toTimingResponse(s.LastLog.Timing)
+	}
+	return res
+}
@@ -1,49 +1,158 @@
 package dto
 
-import "keeplo/internal/domain/monitor"
+import (
+	"encoding/json"
+	"keeplo/internal/domain/monitor"
+	"keeplo/pkg/checker"
+	"time"
+)
+
+// 하트비트 핑 수신 경로. router 의 /api/v1/ping 그룹과 일치해야 함
+const PingPath = "/api/v1/ping/"
 
 // Request --------------------------------------
 
 type RegisterMonitorRequest struct {
-	Name            string `json:"name" binding:"required"`
-	Address         string `json:"address" binding:"required"` // 도메인 or IP
-	Port            string `json:"port" binding:"required"`    // 포트 번호
-	Type            string `json:"type" binding:"required,oneof=http https websocket tcp"`
-	IntervalSeconds int    `json:"interval_seconds" binding:"required,min=10"`
+	Name              string `json:"name" binding:"required"`
+	Address           string `json:"address" binding:"required_unless=Type heartbeat"` // 도메인 or IP
+	Port              string `json:"port"`                                             // 포트 번호. 프로토콜 목록의 host_only 종류(dns, icmp)는 생략
+	Type              string `json:"type" binding:"required"`                          // GET /monitor/protocols 의 이름 또는 별칭
+	IntervalSeconds   int    `json:"interval_seconds" binding:"required,min=10"`
+	FailureThreshold  int    `json:"failure_threshold" binding:"omitempty,min=1,max=10"`  // 기본 3
+	RecoveryThreshold int    `json:"recovery_threshold" binding:"omitempty,min=1,max=10"` // 기본 2
+	TimeoutSeconds    int    `json:"timeout_seconds" binding:"omitempty,min=1,max=60"`    // 기본 10
+	DegradedMs        int    `json:"degraded_ms" binding:"omitempty,min=1,max=60000"`     // 응답 시간이 넘으면 degraded. 기본 사용 안 함
+
+	// 프로토콜별 설정 블록. 키는 GET /monitor/protocols 의 settings 이름, 형식은 fields 참고
+	Settings map[string]json.RawMessage `json:"settings,omitempty"`
 }
 
 type UpdateMonitorRequest struct {
-	Name            *string `json:"name,omitempty"`
-	Address         *string `json:"address,omitempty"`
-	Port            *string `json:"port,omitempty"`
-	Type            *string `json:"type,omitempty"`
-	IntervalSeconds *int    `json:"interval_seconds,omitempty"`
+	Name              *string `json:"name,omitempty"`
+	Address           *string `json:"address,omitempty"`
+	Port              *string `json:"port,omitempty"`
+	Type              *string `json:"type,omitempty"`
+	IntervalSeconds   *int    `json:"interval_seconds,omitempty"`
+	FailureThreshold  *int    `json:"failure_threshold,omitempty" binding:"omitempty,min=1,max=10"`
+	RecoveryThreshold *int    `json:"recovery_threshold,omitempty" binding:"omitempty,min=1,max=10"`
+	TimeoutSeconds    *int    `json:"timeout_seconds,omitempty" binding:"omitempty,min=1,max=60"`
+	DegradedMs        *int    `json:"degraded_ms,omitempty" binding:"omitempty,min=0,max=60000"` // 0 이면 해제
+
+	// 지정한 블록만 통째로 교체하고 null 이면 삭제. 가려진 인증 정보("********")는 기존 값 유지
+	Settings map[string]json.RawMessage `json:"settings,omitempty"`
 }
 
 // Response --------------------------------------
 
 type MonitorResponse struct {
-	ID              string `json:"id"`
-	Name            string `json:"name"`
-	Target          string `json:"target"`
-	Type            string `json:"type"`
-	IntervalSeconds int    `json:"interval_seconds"`
-	Enabled         bool   `json:"enabled"`
-	LastCheckedAt   string `json:"last_checked_at,omitempty"`
-	CreatedAt       string `json:"created_at"`
-	UpdatedAt       string `json:"updated_at"`
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	Target            string `json:"target"`
+	Type              string `json:"type"`
+	IntervalSeconds   int    `json:"interval_seconds"`
+	Enabled           bool   `json:"enabled"`
+	FailureThreshold  int    `json:"failure_threshold"`
+	RecoveryThreshold int    `json:"recovery_threshold"`
+	TimeoutSeconds    int    `json:"timeout_seconds,omitempty"`
+	DegradedMs        int    `json:"degraded_ms,omitempty"`
+	LastCheckedAt     string `json:"last_checked_at,omitempty"`
+	CreatedAt         string `json:"created_at"`
+	UpdatedAt         string `json:"updated_at"`
+
+	Settings map[string]json.RawMessage `json:"settings,omitempty"` // 인증 정보는 "********" 로 가림
+
+	Heartbeat *HeartbeatResponse `json:"heartbeat,omitempty"`
+}
+
+type HeartbeatResponse struct {
+	PingURL      string `json:"ping_url"` // 서버 기준 경로. /start, /fail 을 붙여 POST 로 시작/실패 핑 전송
+	GraceSeconds int    `json:"grace_seconds"`
+	LastPingAt   string `json:"last_ping_at,omitempty"`
+	RunStartedAt string `json:"run_started_at,omitempty"`
 }
 
 func ToMonitorResponse(m *monitor.Monitor) MonitorResponse {
-	return MonitorResponse{
-		ID:              m.ID.String(),
-		Name:            m.Name,
-		Target:          m.Target,
-		Type:            m.Type,
-		IntervalSeconds: m.IntervalSeconds,
-		Enabled:         m.Enabled,
-		LastCheckedAt:   m.LastCheckedAt.String(),
-		CreatedAt:       m.CreatedAt.String(),
-		UpdatedAt:       m.UpdatedAt.String(),
+	res := MonitorResponse{
+		ID:                m.ID.String(),
+		Name:              m.Name,
+		Target:            m.Target,
+		Type:              m.Type,
+		IntervalSeconds:   m.IntervalSeconds,
+		Enabled:           m.Enabled,
+		FailureThreshold:  m.FailureThreshold,
+		RecoveryThreshold: m.RecoveryThreshold,
+		TimeoutSeconds:    m.TimeoutSeconds,
+		DegradedMs:        m.DegradedMs,
+		CreatedAt:         m.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:         m.UpdatedAt.Format(time.RFC3339),
+		Settings:          toSettingsResponse(m.Settings),
+		Heartbeat:         toHeartbeatResponse(m),
+	}
+	// 첫 체크 전에는 nil
+	if m.LastCheckedAt != nil {
+		res.LastCheckedAt = m.LastCheckedAt.Format(time.RFC3339)
+	}
+	return res
+}
+
+// 레지스트리에 없는 블록은 형식을 알 수 없으므로 응답에서 제외
+func toSettingsResponse(s monitor.Settings) map[string]json.RawMessage {
+	if len(s) == 0 {
+		return nil
+	}
+	res := make(map[string]json.RawMessage, len(s))
+	for key, raw := range s {
+		def, ok := checker.LookupSettings(key)
+		if !ok {
+			continue
+		}
+		if masked := def.MaskSecrets(raw); masked != nil {
+			res[key] = masked
+		}
+	}
+	return res
+}
+
+func toHeartbeatResponse(m *monitor.Monitor) *HeartbeatResponse {
+	if !m.IsHeartbeat() {
+		return nil
+	}
+
+	res := &HeartbeatResponse{PingURL: PingPath + m.PingToken}
+	res.GraceSeconds = m.Settings.Heartbeat().GraceSeconds
+	if m.LastPingAt != nil {
+		res.LastPingAt = m.LastPingAt.Format(time.RFC3339)
+	}
+	if m.RunStartedAt != nil {
+		res.RunStartedAt = m.RunStartedAt.Format(time.RFC3339)
+	}
+	return res
+}
+
+// 지원 프로토콜. checker 레지스트리에서 생성
+type ProtocolResponse struct {
+	Name        string                  `json:"name"`
+	Aliases     []string                `json:"aliases,omitempty"`
+	Description string                  `json:"description,omitempty"`
+	Settings    string                  `json:"settings,omitempty"` // 설정 블록 이름
+	Fields      []ProtocolFieldResponse `json:"fields,omitempty"`
+	Secrets     []string                `json:"secrets,omitempty"`   // 저장 시 암호화하고 응답에서 가리는 필드
+	HostOnly    bool                    `json:"host_only,omitempty"` // 포트 없이 주소만 받는 종류
+}
+
+type ProtocolFieldResponse struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+func ToProtocolResponses(defs []checker.Definition) []ProtocolResponse {
+	res := make([]ProtocolResponse, 0, len(defs))
+	for _, d := range defs {
+		p := ProtocolResponse{Name: d.Name, Aliases: d.Aliases, Description: d.Description, Settings: d.Settings, Secrets: d.Secrets, HostOnly: d.HostOnly}
+		for _, f := range d.Fields() {
+			p.Fields = append(p.Fields, ProtocolFieldResponse{Name: f.Name, Type: f.Type})
+		}
+		res = append(res, p)
 	}
+	return res
 }
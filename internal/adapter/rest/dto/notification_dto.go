@@ -0,0 +1,91 @@
+package dto
+
+import (
+	"keeplo/internal/domain/notification"
+	"time"
+)
+
+// Request --------------------------------------
+
+type RegisterChannelRequest struct {
+	Name   string `json:"name" binding:"required,max=50"`
+	Type   string `json:"type" binding:"required,oneof=webhook email slack discord telegram"`
+	Target string `json:"target"` // webhook/slack/discord: URL, email: 계정 이메일만 허용 (비우면 계정 이메일), telegram: chat ID
+	Secret string `json:"secret"` // webhook: 서명 키 (비우면 발급해서 등록 응답으로 한 번만 반환), telegram: 봇 토큰
+}
+
+type UpdateChannelRequest struct {
+	Name    *string `json:"name,omitempty" binding:"omitempty,max=50"`
+	Target  *string `json:"target,omitempty"`
+	Secret  *string `json:"secret,omitempty"`
+	Enabled *bool   `json:"enabled,omitempty"`
+}
+
+// Response --------------------------------------
+
+type ChannelResponse struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Type       string   `json:"type"`
+	Target     string   `json:"target"`
+	HasSecret  bool     `json:"has_secret"`
+	Secret     string   `json:"secret,omitempty"` // webhook 서명 키. 등록 응답에만 포함
+	Enabled    bool     `json:"enabled"`
+	MonitorIDs []string `json:"monitor_ids,omitempty"`
+	CreatedAt  string   `json:"created_at"`
+	UpdatedAt  string   `json:"updated_at"`
+}
+
+// 등록 직후 응답. webhook 은 서명 검증에 필요한 키를 이때만 반환
+func ToRegisteredChannelResponse(ch *notification.Channel) ChannelResponse {
+	res := ToChannelResponse(ch, nil)
+	if ch.Type == notification.ChannelWebhook {
+		res.Secret = ch.Secret
+	}
+	return res
+}
+
+type TestChannelResponse struct {
+	Delivered bool   `json:"delivered"`
+	Error     string `json:"error,omitempty"`
+}
+
+func ToChannelResponse(ch *notification.Channel, monitorIDs []string) ChannelResponse {
+	return ChannelResponse{
+		ID:         ch.ID.String(),
+		Name:       ch.Name,
+		Type:       ch.Type,
+		Target:     ch.Target,
+		HasSecret:  ch.Secret != "",
+		Enabled:    ch.Enabled,
+		MonitorIDs: monitorIDs,
+		CreatedAt:  ch.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  ch.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+type DeliveryResponse struct {
+	ID         string `json:"id"`
+	ChannelID  string `json:"channel_id"`
+	MonitorID  string `json:"monitor_id"`
+	IncidentID string `json:"incident_id,omitempty"`
+	Event      string `json:"event"`
+	Status     string `json:"status"`
+	Attempts   int    `json:"attempts"`
+	Error      string `json:"error,omitempty"`
+	CreatedAt  string `json:"created_at"`
+}
+
+func ToDeliveryResponse(d *notification.Delivery) DeliveryResponse {
+	return DeliveryResponse{
+		ID:         d.ID.String(),
+		ChannelID:  d.ChannelID.String(),
+		MonitorID:  d.MonitorID.String(),
+		IncidentID: d.IncidentID,
+		Event:      d.Event,
+		Status:     d.Status,
+		Attempts:   d.Attempts,
+		Error:      d.Error,
+		CreatedAt:  d.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -2,17 +2,20 @@ package handler
 
 import (
 	"keeplo/internal/application/monitor"
+	"keeplo/internal/application/notification"
 	"keeplo/internal/application/user"
 )
 
 type Handler struct {
-	UserService    user.Service
-	MonitorService monitor.Service
+	UserService         user.Service
+	MonitorService      monitor.Service
+	NotificationService notification.Service
 }
 
-func NewHandler(userService user.Service, monitorService monitor.Service) *Handler {
+func NewHandler(userService user.Service, monitorService monitor.Service, notificationService notification.Service) *Handler {
 	return &Handler{
-		UserService:    userService,
-		MonitorService: monitorService,
+		UserService:         userService,
+		MonitorService:      monitorService,
+		NotificationService: notificationService,
 	}
 }
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"keeplo/internal/adapter/rest/response"
+	"keeplo/internal/domain/monitor"
+	"keeplo/pkg/logger"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// 실패 핑 본문에서 메시지로 남길 최대 크기
+const maxPingBodyBytes = 1024
+
+// PingSuccessHandler godoc
+//
+//	@Summary		하트비트 성공 핑
+//	@Description	작업이 성공적으로 끝났음을 알립니다. 인증 없이 모니터의 ping_url 로 호출합니다.
+//	@Tags			heartbeat
+//	@Produce		json
+//	@Param			token	path		string	true	"하트비트 토큰"
+//	@Success		200		{object}	dto.ResponseFormat
+//	@Failure		404		{object}	dto.ResponseFormat
+//	@Failure		409		{object}	dto.ResponseFormat
+//	@Failure		500		{object}	dto.ResponseFormat
+//	@Router			/ping/{token} [post]
+func (h *Handler) PingSuccessHandler(c *gin.Context) {
+	h.handlePing(c, monitor.PingSuccess)
+}
+
+// PingStartHandler godoc
+//
+//	@Summary		하트비트 시작 핑
+//	@Description	작업 시작을 알립니다. 다음 성공/실패 핑까지의 실행 시간을 기록합니다.
+//	@Tags			heartbeat
+//	@Produce		json
+//	@Param			token	path		string	true	"하트비트 토큰"
+//	@Success		200		{object}	dto.ResponseFormat
+//	@Failure		404		{object}	dto.ResponseFormat
+//	@Failure		409		{object}	dto.ResponseFormat
+//	@Failure		500		{object}	dto.ResponseFormat
+//	@Router			/ping/{token}/start [post]
+func (h *Handler) PingStartHandler(c *gin.Context) {
+	h.handlePing(c, monitor.PingStart)
+}
+
+// PingFailHandler godoc
+//
+//	@Summary		하트비트 실패 핑
+//	@Description	작업 실패를 알립니다. 요청 본문(최대 1KB)은 실패 사유로 기록됩니다.
+//	@Tags			heartbeat
+//	@Accept			plain
+//	@Produce		json
+//	@Param			token	path		string	true	"하트비트 토큰"
+//	@Success		200		{object}	dto.ResponseFormat
+//	@Failure		404		{object}	dto.ResponseFormat
+//	@Failure		409		{object}	dto.ResponseFormat
+//	@Failure		500		{object}	dto.ResponseFormat
+//	@Router			/ping/{token}/fail [post]
+func (h *Handler) PingFailHandler(c *gin.Context) {
+	h.handlePing(c, monitor.PingFail)
+}
+
+func (h *Handler) handlePing(c *gin.Context, kind string) {
+	ctx := c.Request.Context()
+	log := logger.WithContext(ctx)
+
+	var message string
+	if kind == monitor.PingFail && c.Request.Body != nil {
+		body, _ := io.ReadAll(io.LimitReader(c.Request.Body, maxPingBodyBytes))
+		message = string(body)
+	}
+
+	err := h.MonitorService.ReceivePing(ctx, c.Param("token"), kind, message)
+	if err != nil {
+		switch {
+		case errors.Is(err, monitor.ErrMonitorNotFound):
+			response.HandleResponse(c, http.StatusNotFound, response.ErrorMonitorNotFound, nil)
+		case errors.Is(err, monitor.ErrMonitorInactive):
+			response.HandleResponse(c, http.StatusConflict, response.ErrorMonitorInactive, nil)
+		default:
+			log.Error("PingHandler - failed", zap.String("kind", kind), zap.Error(err))
+			response.HandleResponse(c, http.StatusInternalServerError, response.ErrorInternalServer, nil)
+		}
+		return
+	}
+
+	response.HandleResponse(c, http.StatusOK, response.SuccessPingReceived, nil)
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"errors"
+	"keeplo/internal/adapter/rest/dto"
+	"keeplo/internal/adapter/rest/middleware"
+	"keeplo/internal/adapter/rest/response"
+	"keeplo/internal/domain/incident"
+	"keeplo/internal/domain/monitor"
+	"keeplo/pkg/logger"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// AcknowledgeIncidentHandler godoc
+//
+//	@Summary		인시던트 확인
+//	@Description	열린 인시던트를 담당자가 확인했음을 기록합니다. 알림의 incident_id 로 호출합니다.
+//	@Tags			incident
+//	@Produce		json
+//	@Param			id	path		string	true	"인시던트 ID"
+//	@Success		200	{object}	dto.ResponseFormat{data=dto.IncidentResponse}
+//	@Failure		401	{object}	dto.ResponseFormat
+//	@Failure		403	{object}	dto.ResponseFormat
+//	@Failure		404	{object}	dto.ResponseFormat
+//	@Failure		409	{object}	dto.ResponseFormat
+//	@Failure		500	{object}	dto.ResponseFormat
+//	@Router			/incident/{id}/acknowledge [patch]
+func (h *Handler) AcknowledgeIncidentHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	log := logger.WithContext(ctx)
+
+	userID, ok := c.Get(middleware.ContextUserIDKey)
+	if !ok {
+		log.Warn("AcknowledgeIncidentHandler - unauthorized", zap.String("ip", c.ClientIP()))
+		response.HandleResponse(c, http.StatusUnauthorized, response.ErrorUnauthorized, nil)
+		return
+	}
+
+	id := c.Param("id")
+	i, err := h.MonitorService.AcknowledgeIncident(ctx, id, userID.(string))
+	if err != nil {
+		switch {
+		case errors.Is(err, incident.ErrIncidentNotFound):
+			response.HandleResponse(c, http.StatusNotFound, response.ErrorIncidentNotFound, nil)
+		case errors.Is(err, monitor.ErrPermissionDenied):
+			response.HandleResponse(c, http.StatusForbidden, response.ErrorPermissionDenied, nil)
+		case errors.Is(err, incident.ErrIncidentResolved):
+			response.HandleResponse(c, http.StatusConflict, response.ErrorIncidentResolved, nil)
+		case errors.Is(err, incident.ErrAlreadyAcknowledged):
+			response.HandleResponse(c, http.StatusConflict, response.ErrorIncidentAcknowledged, nil)
+		default:
+			log.Error("AcknowledgeIncidentHandler - internal error", zap.Error(err))
+			response.HandleResponse(c, http.StatusInternalServerError, response.ErrorInternalServer, nil)
+		}
+		return
+	}
+
+	log.Info("AcknowledgeIncidentHandler - success", zap.String("incident_id", id), zap.String("user_id", userID.(string)))
+	response.HandleResponse(c, http.StatusOK, response.SuccessIncidentAcknowledged, dto.ToIncidentResponse(i))
+}
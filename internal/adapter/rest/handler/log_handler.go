@@ -1,11 +1,200 @@
 package handler
 
-import "github.com/gin-gonic/gin"
+import (
+	"errors"
+	"keeplo/internal/adapter/rest/dto"
+	"keeplo/internal/adapter/rest/middleware"
+	"keeplo/internal/adapter/rest/response"
+	"keeplo/internal/domain/monitor"
+	"keeplo/pkg/logger"
+	"net/http"
+	"strconv"
 
-func GetMonitorHealthLogHandler(c *gin.Context) {
-	c.JSON(200, gin.H{"message ": "GetMonitorHealthLogHandler"})
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// GetHealthLogsHandler godoc
+//
+//	@Summary		모니터 헬스 로그 조회
+//	@Description	특정 모니터의 헬스 체크 이력을 최신순으로 조회합니다.
+//	@Tags			log
+//	@Produce		json
+//	@Param			monitor_id	path		string	true	"모니터 ID"
+//	@Param			cursor		query		string	false	"이전 응답의 next_cursor"
+//	@Param			limit		query		int		false	"최대 조회 개수 (기본 50, 최대 200)"
+//	@Param			status		query		string	false	"상태 필터 (up | degraded | down)"
+//	@Param			from		query		string	false	"조회 시작 시각 (RFC3339)"
+//	@Param			to			query		string	false	"조회 종료 시각 (RFC3339)"
+//	@Success		200			{object}	dto.ResponseFormat{data=dto.HealthLogListResponse}
+//	@Failure		400			{object}	dto.ResponseFormat
+//	@Failure		403			{object}	dto.ResponseFormat
+//	@Failure		404			{object}	dto.ResponseFormat
+//	@Failure		500			{object}	dto.ResponseFormat
+//	@Router			/log/health/{monitor_id} [get]
+func (h *Handler) GetHealthLogsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	log := logger.WithContext(ctx)
+
+	userID, ok := c.Get(middleware.ContextUserIDKey)
+	if !ok {
+		log.Warn("GetHealthLogsHandler - unauthorized", zap.String("ip", c.ClientIP()))
+		response.HandleResponse(c, http.StatusUnauthorized, response.ErrorUnauthorized, nil)
+		return
+	}
+
+	monitorID := c.Param("monitor_id")
+	var req dto.HealthLogQueryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		log.Warn("GetHealthLogsHandler - invalid query", zap.Error(err))
+		response.HandleResponse(c, http.StatusBadRequest, response.ErrorValidationFailed, nil)
+		return
+	}
+	log.Debug("GetHealthLogsHandler called", zap.String("monitor_id", monitorID), zap.Int("limit", req.Limit))
+
+	logs, next, err := h.MonitorService.SearchHealthLogs(ctx, monitorID, userID.(string), req)
+	if err != nil {
+		handleHealthLogError(c, "GetHealthLogsHandler", err)
+		return
+	}
+
+	response.HandleResponse(c, http.StatusOK, response.SuccessHealthLogListed, dto.NewHealthLogListResponse(logs, next))
+}
+
+// GetAllHealthLogsHandler godoc
+//
+//	@Summary		전체 헬스 로그 조회
+//	@Description	사용자가 등록한 모든 모니터의 헬스 체크 이력을 최신순으로 조회합니다.
+//	@Tags			log
+//	@Produce		json
+//	@Param			cursor	query		string	false	"이전 응답의 next_cursor"
+//	@Param			limit	query		int		false	"최대 조회 개수 (기본 50, 최대 200)"
+//	@Param			status	query		string	false	"상태 필터 (up | degraded | down)"
+//	@Param			from	query		string	false	"조회 시작 시각 (RFC3339)"
+//	@Param			to		query		string	false	"조회 종료 시각 (RFC3339)"
+//	@Success		200		{object}	dto.ResponseFormat{data=dto.HealthLogListResponse}
+//	@Failure		400		{object}	dto.ResponseFormat
+//	@Failure		500		{object}	dto.ResponseFormat
+//	@Router			/log/health [get]
+func (h *Handler) GetAllHealthLogsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	log := logger.WithContext(ctx)
+
+	userID, ok := c.Get(middleware.ContextUserIDKey)
+	if !ok {
+		log.Warn("GetAllHealthLogsHandler - unauthorized", zap.String("ip", c.ClientIP()))
+		response.HandleResponse(c, http.StatusUnauthorized, response.ErrorUnauthorized, nil)
+		return
+	}
+
+	var req dto.HealthLogQueryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		log.Warn("GetAllHealthLogsHandler - invalid query", zap.Error(err))
+		response.HandleResponse(c, http.StatusBadRequest, response.ErrorValidationFailed, nil)
+		return
+	}
+
+	logs, next, err := h.MonitorService.SearchUserHealthLogs(ctx, userID.(string), req)
+	if err != nil {
+		handleHealthLogError(c, "GetAllHealthLogsHandler", err)
+		return
+	}
+
+	response.HandleResponse(c, http.StatusOK, response.SuccessHealthLogListed, dto.NewHealthLogListResponse(logs, next))
 }
 
-func GetMonitorStatusHandler(c *gin.Context) {
-	c.JSON(200, gin.H{"message ": "GetMonitorStatusHandler"})
+// GetMonitorStatusHandler godoc
+//
+//	@Summary		모니터 상태 조회
+//	@Description	사용자가 등록한 모니터별 가장 최근 체크 결과를 조회합니다.
+//	@Tags			log
+//	@Produce		json
+//	@Success		200	{object}	dto.ResponseFormat{data=[]dto.MonitorStatusResponse}
+//	@Failure		401	{object}	dto.ResponseFormat
+//	@Failure		500	{object}	dto.ResponseFormat
+//	@Router			/log/status [get]
+func (h *Handler) GetMonitorStatusHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	log := logger.WithContext(ctx)
+
+	userID, ok := c.Get(middleware.ContextUserIDKey)
+	if !ok {
+		log.Warn("GetMonitorStatusHandler - unauthorized", zap.String("ip", c.ClientIP()))
+		response.HandleResponse(c, http.StatusUnauthorized, response.ErrorUnauthorized, nil)
+		return
+	}
+
+	statuses, err := h.MonitorService.SearchMonitorStatuses(ctx, userID.(string))
+	if err != nil {
+		log.Error("GetMonitorStatusHandler - fetch failed", zap.Error(err))
+		response.HandleResponse(c, http.StatusInternalServerError, response.ErrorInternalServer, nil)
+		return
+	}
+
+	list := make([]dto.MonitorStatusResponse, 0, len(statuses))
+	for _, s := range statuses {
+		list = append(list, dto.ToMonitorStatusResponse(s))
+	}
+	response.HandleResponse(c, http.StatusOK, response.SuccessMonitorStatusListed, list)
+}
+
+func handleHealthLogError(c *gin.Context, name string, err error) {
+	switch {
+	case errors.Is(err, monitor.ErrMonitorNotFound):
+		response.HandleResponse(c, http.StatusNotFound, response.ErrorMonitorNotFound, nil)
+	case errors.Is(err, monitor.ErrPermissionDenied):
+		response.HandleResponse(c, http.StatusForbidden, response.ErrorPermissionDenied, nil)
+	case errors.Is(err, monitor.ErrInvalidLogQuery):
+		response.HandleResponse(c, http.StatusBadRequest, response.ErrorInvalidLogQuery, nil)
+	case errors.Is(err, monitor.ErrInvalidCursor):
+		response.HandleResponse(c, http.StatusBadRequest, response.ErrorInvalidCursor, nil)
+	default:
+		logger.WithContext(c.Request.Context()).Error(name+" - fetch failed", zap.Error(err))
+		response.HandleResponse(c, http.StatusInternalServerError, response.ErrorDatabase, nil)
+	}
+}
+
+// GetNotificationLogsHandler godoc
+//
+//	@Summary		알림 전송 이력 조회
+//	@Description	특정 모니터의 알림 전송 이력을 최신순으로 조회합니다.
+//	@Tags			log
+//	@Produce		json
+//	@Param			monitor_id	path		string	true	"모니터 ID"
+//	@Param			limit		query		int		false	"최대 조회 개수 (기본 50, 최대 200)"
+//	@Success		200			{object}	dto.ResponseFormat{data=[]dto.DeliveryResponse}
+//	@Failure		403			{object}	dto.ResponseFormat
+//	@Failure		404			{object}	dto.ResponseFormat
+//	@Failure		500			{object}	dto.ResponseFormat
+//	@Router			/log/notifications/{monitor_id} [get]
+func (h *Handler) GetNotificationLogsHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	log := logger.WithContext(ctx)
+
+	userID, ok := c.Get(middleware.ContextUserIDKey)
+	if !ok {
+		log.Warn("GetNotificationLogsHandler - unauthorized", zap.String("ip", c.ClientIP()))
+		response.HandleResponse(c, http.StatusUnauthorized, response.ErrorUnauthorized, nil)
+		return
+	}
+
+	monitorID := c.Param("monitor_id")
+	limit := 0
+	if l := c.Query("limit"); l != "" {
+		if v, err := strconv.Atoi(l); err == nil && v > 0 {
+			limit = v
+		}
+	}
+
+	deliveries, err := h.NotificationService.SearchDeliveries(ctx, monitorID, userID.(string), limit)
+	if err != nil {
+		handleHealthLogError(c, "GetNotificationLogsHandler", err)
+		return
+	}
+
+	list := make([]dto.DeliveryResponse, 0, len(deliveries))
+	for _, d := range deliveries {
+		list = append(list, dto.ToDeliveryResponse(d))
+	}
+	response.HandleResponse(c, http.StatusOK, response.SuccessNotificationLogListed, list)
 }
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"keeplo/internal/adapter/rest/dto"
+	"keeplo/internal/adapter/rest/middleware"
+	"keeplo/internal/adapter/rest/response"
+	appmonitor "keeplo/internal/application/monitor"
+	appnotification "keeplo/internal/application/notification"
+	"keeplo/internal/domain/monitor"
+	"keeplo/pkg/logger"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// postgres 처럼 UUID 가 아닌 ID 에 형변환 오류를 돌려주는 저장소
+type uuidCastRepo struct {
+	monitor.Repository
+}
+
+func (r *uuidCastRepo) FindByID(ctx context.Context, id string) (*monitor.Monitor, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, errors.New("invalid input syntax for type uuid")
+	}
+	return nil, errors.New("unexpected lookup")
+}
+
+func TestLogHandlers_InvalidMonitorID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger.Log = zap.NewNop()
+
+	repo := &uuidCastRepo{}
+	h := &Handler{
+		MonitorService:      appmonitor.NewMonitorService(repo, nil, nil, nil, nil),
+		NotificationService: appnotification.NewNotificationService(repo, nil, nil, nil, nil),
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		handler gin.HandlerFunc
+	}{
+		{"health logs", "/log/health/not-a-uuid", h.GetHealthLogsHandler},
+		{"notification logs", "/log/notifications/not-a-uuid", h.GetNotificationLogsHandler},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, tt.path, nil)
+			c.Params = gin.Params{{Key: "monitor_id", Value: "not-a-uuid"}}
+			c.Set(middleware.ContextUserIDKey, uuid.NewString())
+
+			tt.handler(c)
+
+			require.Equal(t, http.StatusNotFound, w.Code)
+			var res dto.ResponseFormat
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+			assert.Equal(t, int(response.ErrorMonitorNotFound), res.ErrorCode)
+		})
+	}
+}
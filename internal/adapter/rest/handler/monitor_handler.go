@@ -8,7 +8,6 @@ import (
 	"keeplo/internal/domain/monitor"
 	"keeplo/pkg/logger"
 	"net/http"
-	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -52,6 +51,8 @@ func (h *Handler) RegisterMonitorHandler(c *gin.Context) {
 		switch {
 		case errors.Is(err, monitor.ErrInvalidMonitorData):
 			response.HandleResponse(c, http.StatusBadRequest, response.ErrorValidationFailed, nil)
+		case errors.Is(err, monitor.ErrUnsupportedProtocol):
+			response.HandleResponse(c, http.StatusBadRequest, response.ErrorInvalidProtocol, nil)
 		default:
 			log.Error("RegisterMonitorHandler - internal error", zap.Error(err))
 			response.HandleResponse(c, http.StatusInternalServerError, response.ErrorMonitorRegisterFailed, nil)
@@ -112,6 +113,8 @@ func (h *Handler) GetMonitorListHandler(c *gin.Context) {
 //	@Success		200	{object}	dto.ResponseFormat{data=dto.MonitorResponse}
 //	@Failure		400	{object}	dto.ResponseFormat
 //	@Failure		401	{object}	dto.ResponseFormat
+//	@Failure		403	{object}	dto.ResponseFormat
+//	@Failure		404	{object}	dto.ResponseFormat
 //	@Failure		500	{object}	dto.ResponseFormat
 //	@Router			/monitor/{id} [get]
 func (h *Handler) GetMonitorHandler(c *gin.Context) {
@@ -126,11 +129,13 @@ func (h *Handler) GetMonitorHandler(c *gin.Context) {
 	}
 
 	id := c.Param("id")
-	monitorObj, err := h.MonitorService.SearchMonitor(ctx, id)
+	monitorObj, err := h.MonitorService.SearchMonitor(ctx, id, userID.(string))
 	if err != nil {
 		switch {
 		case errors.Is(err, monitor.ErrMonitorNotFound):
 			response.HandleResponse(c, http.StatusNotFound, response.ErrorMonitorNotFound, nil)
+		case errors.Is(err, monitor.ErrPermissionDenied):
+			response.HandleResponse(c, http.StatusForbidden, response.ErrorPermissionDenied, nil)
 		default:
 			log.Error("GetMonitorHandler - fetch failed", zap.Error(err))
 			response.HandleResponse(c, http.StatusInternalServerError, response.ErrorMonitorFetchFailed, nil)
@@ -182,6 +187,10 @@ func (h *Handler) UpdateMonitorHandler(c *gin.Context) {
 			response.HandleResponse(c, http.StatusNotFound, response.ErrorMonitorNotFound, nil)
 		case errors.Is(err, monitor.ErrPermissionDenied):
 			response.HandleResponse(c, http.StatusForbidden, response.ErrorPermissionDenied, nil)
+		case errors.Is(err, monitor.ErrInvalidMonitorData):
+			response.HandleResponse(c, http.StatusBadRequest, response.ErrorValidationFailed, nil)
+		case errors.Is(err, monitor.ErrUnsupportedProtocol):
+			response.HandleResponse(c, http.StatusBadRequest, response.ErrorInvalidProtocol, nil)
 		default:
 			log.Error("UpdateMonitorHandler - update failed", zap.Error(err))
 			response.HandleResponse(c, http.StatusInternalServerError, response.ErrorMonitorUpdateFailed, nil)
@@ -250,7 +259,13 @@ func (h *Handler) ToggleMonitorHandler(c *gin.Context) {
 	ctx := c.Request.Context()
 	log := logger.WithContext(ctx)
 
-	userID := c.MustGet(middleware.ContextUserIDKey).(string)
+	uid, ok := c.Get(middleware.ContextUserIDKey)
+	if !ok {
+		log.Warn("ToggleMonitorHandler - unauthorized", zap.String("ip", c.ClientIP()))
+		response.HandleResponse(c, http.StatusUnauthorized, response.ErrorUnauthorized, nil)
+		return
+	}
+	userID := uid.(string)
 	monitorID := c.Param("id")
 
 	log.Debug("ToggleMonitorHandler called", zap.String("monitor_id", monitorID))
@@ -272,11 +287,12 @@ func (h *Handler) ToggleMonitorHandler(c *gin.Context) {
 // TriggerMonitorHandler godoc
 //
 //	@Summary		모니터링 수동 실행
-//	@Description	선택한 모니터링 항목을 즉시 테스트 실행합니다.
+//	@Description	선택한 모니터링 항목을 즉시 테스트 실행하고 결과를 반환합니다.
 //	@Tags			monitor
 //	@Produce		json
 //	@Param			id	path	string	true	"모니터 ID"
-//	@Success		200	{object}	dto.ResponseFormat
+//	@Success		200	{object}	dto.ResponseFormat{data=dto.HealthLogResponse}
+//	@Failure		400	{object}	dto.ResponseFormat
 //	@Failure		404	{object}	dto.ResponseFormat
 //	@Failure		403	{object}	dto.ResponseFormat
 //	@Failure		500	{object}	dto.ResponseFormat
@@ -285,66 +301,46 @@ func (h *Handler) TriggerMonitorHandler(c *gin.Context) {
 	ctx := c.Request.Context()
 	log := logger.WithContext(ctx)
 
-	userID := c.MustGet(middleware.ContextUserIDKey).(string)
+	uid, ok := c.Get(middleware.ContextUserIDKey)
+	if !ok {
+		log.Warn("TriggerMonitorHandler - unauthorized", zap.String("ip", c.ClientIP()))
+		response.HandleResponse(c, http.StatusUnauthorized, response.ErrorUnauthorized, nil)
+		return
+	}
+	userID := uid.(string)
 	monitorID := c.Param("id")
 	log.Debug("TriggerMonitorHandler called", zap.String("monitor_id", monitorID), zap.String("user_id", userID))
 
-	if err := h.MonitorService.TriggerMonitor(ctx, monitorID, userID); err != nil {
-		switch err {
-		case monitor.ErrMonitorNotFound:
+	result, err := h.MonitorService.TriggerMonitor(ctx, monitorID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, monitor.ErrMonitorNotFound):
 			response.HandleResponse(c, http.StatusNotFound, response.ErrorMonitorNotFound, nil)
-		case monitor.ErrPermissionDenied:
+		case errors.Is(err, monitor.ErrPermissionDenied):
 			response.HandleResponse(c, http.StatusForbidden, response.ErrorUnauthorized, nil)
+		case errors.Is(err, monitor.ErrUnsupportedProtocol):
+			response.HandleResponse(c, http.StatusBadRequest, response.ErrorInvalidProtocol, nil)
 		default:
-			response.HandleResponse(c, http.StatusInternalServerError, response.ErrorMonitorRegisterFailed, nil)
+			response.HandleResponse(c, http.StatusInternalServerError, response.ErrorMonitorTriggerFailed, nil)
 		}
 		return
 	}
-	response.HandleResponse(c, http.StatusOK, response.Success, nil)
+	if result == nil {
+		response.HandleResponse(c, http.StatusOK, response.SuccessMonitorTriggered, nil)
+		return
+	}
+	response.HandleResponse(c, http.StatusOK, response.SuccessMonitorTriggered, dto.ToHealthLogResponse(result))
 }
 
 // GetSupportedProtocolsHandler godoc
 //
 //	@Summary		지원 프로토콜 조회
-//	@Description	서버에서 지원하는 모니터링 프로토콜 목록을 반환합니다.
+//	@Description	서버에서 지원하는 모니터링 프로토콜과 별칭, 설정 필드 목록을 반환합니다.
 //	@Tags			monitor
 //	@Produce		json
 //	@Success		200	{object}	dto.ResponseFormat
 //	@Router			/monitor/protocols [get]
 func (h *Handler) GetSupportedProtocolsHandler(c *gin.Context) {
 	protocols := h.MonitorService.GetSupportedProtocols()
-	response.HandleResponse(c, http.StatusOK, response.Success, protocols)
-}
-
-// GetHealthLogsHandler godoc
-//
-//	@Summary		모니터 헬스 로그 조회
-//	@Description	특정 모니터의 헬스 체크 이력을 조회합니다.
-//	@Tags			log
-//	@Produce		json
-//	@Param			id		query		string	true	"모니터 ID"
-//	@Param			limit	query		int		false	"최대 조회 개수 (기본 50)"
-//	@Success		200		{object}	dto.ResponseFormat
-//	@Failure		400		{object}	dto.ResponseFormat
-//	@Failure		404		{object}	dto.ResponseFormat
-//	@Router			/log/health/{id} [get]
-func (h *Handler) GetHealthLogsHandler(c *gin.Context) {
-	ctx := c.Request.Context()
-	log := logger.WithContext(ctx)
-
-	monitorID := c.Query("id")
-	if monitorID == "" {
-		log.Warn("GetHealthLogsHandler - missing monitor ID")
-		response.HandleResponse(c, http.StatusBadRequest, response.ErrorBadRequest, nil)
-		return
-	}
-
-	limit := 50
-	if l := c.Query("limit"); l != "" {
-		if v, err := strconv.Atoi(l); err == nil && v > 0 {
-			limit = v
-		}
-	}
-	log.Debug("GetHealthLogsHandler called", zap.String("monitor_id", monitorID), zap.Int("limit", limit))
-
+	response.HandleResponse(c, http.StatusOK, response.Success, dto.ToProtocolResponses(protocols))
 }
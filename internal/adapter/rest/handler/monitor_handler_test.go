@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"keeplo/internal/adapter/rest/dto"
+	"keeplo/internal/adapter/rest/middleware"
+	"keeplo/internal/adapter/rest/response"
+	appmonitor "keeplo/internal/application/monitor"
+	"keeplo/internal/domain/monitor"
+	"keeplo/pkg/logger"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// FindByID 만 구현한 메모리 저장소
+type memMonitorRepo struct {
+	monitor.Repository
+	monitors map[string]*monitor.Monitor
+}
+
+func (r *memMonitorRepo) FindByID(ctx context.Context, id string) (*monitor.Monitor, error) {
+	m, ok := r.monitors[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return m, nil
+}
+
+func TestGetMonitorHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger.Log = zap.NewNop()
+
+	owner, other := uuid.New(), uuid.New()
+	heartbeat := &monitor.Monitor{
+		ID:        uuid.New(),
+		UserID:    owner,
+		Name:      "nightly backup",
+		Type:      monitor.TypeHeartbeat,
+		Target:    monitor.TypeHeartbeat,
+		PingToken: "ping-token",
+	}
+	repo := &memMonitorRepo{monitors: map[string]*monitor.Monitor{heartbeat.ID.String(): heartbeat}}
+	h := &Handler{MonitorService: appmonitor.NewMonitorService(repo, nil, nil, nil, nil)}
+
+	tests := []struct {
+		name      string
+		userID    string
+		monitorID string
+		wantCode  int
+		wantError response.StatusCode
+	}{
+		{name: "owner", userID: owner.String(), monitorID: heartbeat.ID.String(), wantCode: http.StatusOK},
+		{name: "other user", userID: other.String(), monitorID: heartbeat.ID.String(), wantCode: http.StatusForbidden, wantError: response.ErrorPermissionDenied},
+		{name: "not found", userID: owner.String(), monitorID: uuid.NewString(), wantCode: http.StatusNotFound, wantError: response.ErrorMonitorNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/monitor/"+tt.monitorID, nil)
+			c.Params = gin.Params{{Key: "id", Value: tt.monitorID}}
+			c.Set(middleware.ContextUserIDKey, tt.userID)
+
+			h.GetMonitorHandler(c)
+
+			require.Equal(t, tt.wantCode, w.Code)
+			body := w.Body.String()
+			if tt.wantCode != http.StatusOK {
+				var res dto.ResponseFormat
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+				assert.Equal(t, int(tt.wantError), res.ErrorCode)
+				assert.NotContains(t, body, heartbeat.PingToken)
+				return
+			}
+			assert.Contains(t, body, heartbeat.PingToken)
+		})
+	}
+}
+
+func TestGetMonitorHandler_Unauthorized(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger.Log = zap.NewNop()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/monitor/x", nil)
+
+	(&Handler{}).GetMonitorHandler(c)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
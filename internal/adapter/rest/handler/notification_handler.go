@@ -0,0 +1,335 @@
+package handler
+
+import (
+	"errors"
+	"keeplo/internal/adapter/rest/dto"
+	"keeplo/internal/adapter/rest/middleware"
+	"keeplo/internal/adapter/rest/response"
+	"keeplo/internal/domain/monitor"
+	"keeplo/internal/domain/notification"
+	"keeplo/pkg/logger"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// RegisterChannelHandler godoc
+//
+//	@Summary		알림 채널 추가
+//	@Description	장애/복구 알림을 받을 채널(webhook, email, slack, discord, telegram)을 등록합니다.
+//	@Tags			notification
+//	@Accept			json
+//	@Produce		json
+//	@Param			channel	body		dto.RegisterChannelRequest	true	"신규 알림 채널 등록 요청"
+//	@Success		200		{object}	dto.ResponseFormat{data=dto.ChannelResponse}
+//	@Failure		400		{object}	dto.ResponseFormat
+//	@Failure		401		{object}	dto.ResponseFormat
+//	@Failure		500		{object}	dto.ResponseFormat
+//	@Router			/notifications [post]
+func (h *Handler) RegisterChannelHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	log := logger.WithContext(ctx)
+
+	userID, ok := c.Get(middleware.ContextUserIDKey)
+	if !ok {
+		log.Warn("RegisterChannelHandler - unauthorized", zap.String("ip", c.ClientIP()))
+		response.HandleResponse(c, http.StatusUnauthorized, response.ErrorUnauthorized, nil)
+		return
+	}
+
+	var req dto.RegisterChannelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Warn("RegisterChannelHandler - invalid request", zap.Error(err))
+		response.HandleResponse(c, http.StatusBadRequest, response.ErrorValidationFailed, nil)
+		return
+	}
+
+	ch, err := h.NotificationService.RegisterChannel(ctx, userID.(string), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, notification.ErrInvalidChannelData):
+			response.HandleResponse(c, http.StatusBadRequest, response.ErrorInvalidChannel, nil)
+		case errors.Is(err, notification.ErrUnsupportedChannel):
+			response.HandleResponse(c, http.StatusBadRequest, response.ErrorUnsupportedChannel, nil)
+		default:
+			log.Error("RegisterChannelHandler - internal error", zap.Error(err))
+			response.HandleResponse(c, http.StatusInternalServerError, response.ErrorChannelRegisterFailed, nil)
+		}
+		return
+	}
+
+	log.Info("RegisterChannelHandler - success", zap.String("user_id", userID.(string)))
+	response.HandleResponse(c, http.StatusOK, response.SuccessChannelRegistered, dto.ToRegisteredChannelResponse(ch))
+}
+
+// GetChannelListHandler godoc
+//
+//	@Summary		알림 채널 목록 조회
+//	@Description	사용자가 등록한 모든 알림 채널을 조회합니다.
+//	@Tags			notification
+//	@Produce		json
+//	@Success		200	{object}	dto.ResponseFormat{data=[]dto.ChannelResponse}
+//	@Failure		401	{object}	dto.ResponseFormat
+//	@Failure		500	{object}	dto.ResponseFormat
+//	@Router			/notifications [get]
+func (h *Handler) GetChannelListHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	log := logger.WithContext(ctx)
+
+	userID, ok := c.Get(middleware.ContextUserIDKey)
+	if !ok {
+		log.Warn("GetChannelListHandler - unauthorized", zap.String("ip", c.ClientIP()))
+		response.HandleResponse(c, http.StatusUnauthorized, response.ErrorUnauthorized, nil)
+		return
+	}
+
+	channels, err := h.NotificationService.SearchChannelList(ctx, userID.(string))
+	if err != nil {
+		log.Error("GetChannelListHandler - fetch failed", zap.Error(err))
+		response.HandleResponse(c, http.StatusInternalServerError, response.ErrorInternalServer, nil)
+		return
+	}
+
+	list := make([]dto.ChannelResponse, 0, len(channels))
+	for _, ch := range channels {
+		list = append(list, dto.ToChannelResponse(ch, nil))
+	}
+	response.HandleResponse(c, http.StatusOK, response.SuccessChannelListed, list)
+}
+
+// GetChannelHandler godoc
+//
+//	@Summary		알림 채널 상세 조회
+//	@Description	알림 채널 정보와 연결된 모니터 목록을 조회합니다.
+//	@Tags			notification
+//	@Produce		json
+//	@Param			id	path		string	true	"알림 채널 ID"
+//	@Success		200	{object}	dto.ResponseFormat{data=dto.ChannelResponse}
+//	@Failure		403	{object}	dto.ResponseFormat
+//	@Failure		404	{object}	dto.ResponseFormat
+//	@Failure		500	{object}	dto.ResponseFormat
+//	@Router			/notifications/{id} [get]
+func (h *Handler) GetChannelHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	log := logger.WithContext(ctx)
+
+	userID, ok := c.Get(middleware.ContextUserIDKey)
+	if !ok {
+		log.Warn("GetChannelHandler - unauthorized", zap.String("ip", c.ClientIP()))
+		response.HandleResponse(c, http.StatusUnauthorized, response.ErrorUnauthorized, nil)
+		return
+	}
+
+	id := c.Param("id")
+	ch, monitorIDs, err := h.NotificationService.SearchChannel(ctx, id, userID.(string))
+	if err != nil {
+		handleChannelError(c, "GetChannelHandler", err, response.ErrorInternalServer)
+		return
+	}
+
+	response.HandleResponse(c, http.StatusOK, response.SuccessChannelFetched, dto.ToChannelResponse(ch, monitorIDs))
+}
+
+// UpdateChannelHandler godoc
+//
+//	@Summary		알림 채널 수정
+//	@Description	알림 채널의 이름, 대상, 비밀 키, 활성 여부를 수정합니다.
+//	@Tags			notification
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string						true	"알림 채널 ID"
+//	@Param			channel	body		dto.UpdateChannelRequest	true	"수정할 정보"
+//	@Success		200		{object}	dto.ResponseFormat
+//	@Failure		400		{object}	dto.ResponseFormat
+//	@Failure		403		{object}	dto.ResponseFormat
+//	@Failure		404		{object}	dto.ResponseFormat
+//	@Failure		500		{object}	dto.ResponseFormat
+//	@Router			/notifications/{id} [put]
+func (h *Handler) UpdateChannelHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	log := logger.WithContext(ctx)
+
+	userID, ok := c.Get(middleware.ContextUserIDKey)
+	if !ok {
+		log.Warn("UpdateChannelHandler - unauthorized", zap.String("ip", c.ClientIP()))
+		response.HandleResponse(c, http.StatusUnauthorized, response.ErrorUnauthorized, nil)
+		return
+	}
+
+	id := c.Param("id")
+	var req dto.UpdateChannelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Warn("UpdateChannelHandler - invalid request", zap.Error(err))
+		response.HandleResponse(c, http.StatusBadRequest, response.ErrorValidationFailed, nil)
+		return
+	}
+
+	if err := h.NotificationService.ModifyChannel(ctx, id, userID.(string), req); err != nil {
+		handleChannelError(c, "UpdateChannelHandler", err, response.ErrorChannelUpdateFailed)
+		return
+	}
+
+	log.Info("UpdateChannelHandler - success", zap.String("channel_id", id), zap.String("user_id", userID.(string)))
+	response.HandleResponse(c, http.StatusOK, response.SuccessChannelUpdated, nil)
+}
+
+// RemoveChannelHandler godoc
+//
+//	@Summary		알림 채널 삭제
+//	@Description	알림 채널과 모니터 연결을 함께 삭제합니다.
+//	@Tags			notification
+//	@Produce		json
+//	@Param			id	path		string	true	"알림 채널 ID"
+//	@Success		200	{object}	dto.ResponseFormat
+//	@Failure		403	{object}	dto.ResponseFormat
+//	@Failure		404	{object}	dto.ResponseFormat
+//	@Failure		500	{object}	dto.ResponseFormat
+//	@Router			/notifications/{id} [delete]
+func (h *Handler) RemoveChannelHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	log := logger.WithContext(ctx)
+
+	userID, ok := c.Get(middleware.ContextUserIDKey)
+	if !ok {
+		log.Warn("RemoveChannelHandler - unauthorized", zap.String("ip", c.ClientIP()))
+		response.HandleResponse(c, http.StatusUnauthorized, response.ErrorUnauthorized, nil)
+		return
+	}
+
+	id := c.Param("id")
+	if err := h.NotificationService.DeleteChannel(ctx, id, userID.(string)); err != nil {
+		handleChannelError(c, "RemoveChannelHandler", err, response.ErrorChannelDeleteFailed)
+		return
+	}
+
+	log.Info("RemoveChannelHandler - success", zap.String("channel_id", id), zap.String("user_id", userID.(string)))
+	response.HandleResponse(c, http.StatusOK, response.SuccessChannelDeleted, nil)
+}
+
+// TestChannelHandler godoc
+//
+//	@Summary		알림 채널 테스트
+//	@Description	알림 채널로 테스트 메시지를 전송하고 결과를 반환합니다.
+//	@Tags			notification
+//	@Produce		json
+//	@Param			id	path		string	true	"알림 채널 ID"
+//	@Success		200	{object}	dto.ResponseFormat{data=dto.TestChannelResponse}
+//	@Failure		403	{object}	dto.ResponseFormat
+//	@Failure		404	{object}	dto.ResponseFormat
+//	@Failure		429	{object}	dto.ResponseFormat
+//	@Failure		500	{object}	dto.ResponseFormat
+//	@Router			/notifications/{id}/test [post]
+func (h *Handler) TestChannelHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	log := logger.WithContext(ctx)
+
+	userID, ok := c.Get(middleware.ContextUserIDKey)
+	if !ok {
+		log.Warn("TestChannelHandler - unauthorized", zap.String("ip", c.ClientIP()))
+		response.HandleResponse(c, http.StatusUnauthorized, response.ErrorUnauthorized, nil)
+		return
+	}
+
+	id := c.Param("id")
+	err := h.NotificationService.TestChannel(ctx, id, userID.(string))
+	if err != nil && !errors.Is(err, notification.ErrChannelTestFailed) {
+		handleChannelError(c, "TestChannelHandler", err, response.ErrorInternalServer)
+		return
+	}
+
+	// 전송 실패는 사용자가 설정을 고칠 수 있도록 원인과 함께 결과로 전달
+	result := dto.TestChannelResponse{Delivered: err == nil}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	response.HandleResponse(c, http.StatusOK, response.SuccessChannelTested, result)
+}
+
+// AttachChannelHandler godoc
+//
+//	@Summary		모니터에 알림 채널 연결
+//	@Description	모니터 상태 변화 시 해당 알림 채널로 알림을 보내도록 연결합니다.
+//	@Tags			notification
+//	@Produce		json
+//	@Param			id			path		string	true	"알림 채널 ID"
+//	@Param			monitor_id	path		string	true	"모니터 ID"
+//	@Success		200			{object}	dto.ResponseFormat
+//	@Failure		403			{object}	dto.ResponseFormat
+//	@Failure		404			{object}	dto.ResponseFormat
+//	@Failure		500			{object}	dto.ResponseFormat
+//	@Router			/notifications/{id}/monitors/{monitor_id} [put]
+func (h *Handler) AttachChannelHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	log := logger.WithContext(ctx)
+
+	userID, ok := c.Get(middleware.ContextUserIDKey)
+	if !ok {
+		log.Warn("AttachChannelHandler - unauthorized", zap.String("ip", c.ClientIP()))
+		response.HandleResponse(c, http.StatusUnauthorized, response.ErrorUnauthorized, nil)
+		return
+	}
+
+	channelID := c.Param("id")
+	monitorID := c.Param("monitor_id")
+	if err := h.NotificationService.AttachMonitor(ctx, channelID, monitorID, userID.(string)); err != nil {
+		handleChannelError(c, "AttachChannelHandler", err, response.ErrorChannelUpdateFailed)
+		return
+	}
+
+	response.HandleResponse(c, http.StatusOK, response.SuccessChannelAttached, nil)
+}
+
+// DetachChannelHandler godoc
+//
+//	@Summary		모니터에서 알림 채널 연결 해제
+//	@Description	모니터와 알림 채널의 연결을 해제합니다.
+//	@Tags			notification
+//	@Produce		json
+//	@Param			id			path		string	true	"알림 채널 ID"
+//	@Param			monitor_id	path		string	true	"모니터 ID"
+//	@Success		200			{object}	dto.ResponseFormat
+//	@Failure		403			{object}	dto.ResponseFormat
+//	@Failure		404			{object}	dto.ResponseFormat
+//	@Failure		500			{object}	dto.ResponseFormat
+//	@Router			/notifications/{id}/monitors/{monitor_id} [delete]
+func (h *Handler) DetachChannelHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	log := logger.WithContext(ctx)
+
+	userID, ok := c.Get(middleware.ContextUserIDKey)
+	if !ok {
+		log.Warn("DetachChannelHandler - unauthorized", zap.String("ip", c.ClientIP()))
+		response.HandleResponse(c, http.StatusUnauthorized, response.ErrorUnauthorized, nil)
+		return
+	}
+
+	channelID := c.Param("id")
+	monitorID := c.Param("monitor_id")
+	if err := h.NotificationService.DetachMonitor(ctx, channelID, monitorID, userID.(string)); err != nil {
+		handleChannelError(c, "DetachChannelHandler", err, response.ErrorChannelUpdateFailed)
+		return
+	}
+
+	response.HandleResponse(c, http.StatusOK, response.SuccessChannelDetached, nil)
+}
+
+func handleChannelError(c *gin.Context, name string, err error, fallback response.StatusCode) {
+	switch {
+	case errors.Is(err, notification.ErrChannelNotFound):
+		response.HandleResponse(c, http.StatusNotFound, response.ErrorChannelNotFound, nil)
+	case errors.Is(err, monitor.ErrMonitorNotFound):
+		response.HandleResponse(c, http.StatusNotFound, response.ErrorMonitorNotFound, nil)
+	case errors.Is(err, notification.ErrPermissionDenied), errors.Is(err, monitor.ErrPermissionDenied):
+		response.HandleResponse(c, http.StatusForbidden, response.ErrorPermissionDenied, nil)
+	case errors.Is(err, notification.ErrInvalidChannelData):
+		response.HandleResponse(c, http.StatusBadRequest, response.ErrorInvalidChannel, nil)
+	case errors.Is(err, notification.ErrUnsupportedChannel):
+		response.HandleResponse(c, http.StatusBadRequest, response.ErrorUnsupportedChannel, nil)
+	case errors.Is(err, notification.ErrTestRateLimited):
+		response.HandleResponse(c, http.StatusTooManyRequests, response.ErrorRateLimitExceeded, nil)
+	default:
+		logger.WithContext(c.Request.Context()).Error(name+" - internal error", zap.Error(err))
+		response.HandleResponse(c, http.StatusInternalServerError, fallback, nil)
+	}
+}
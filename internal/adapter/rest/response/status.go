@@ -12,6 +12,8 @@ const (
 	SuccessMonitorDeleted    StatusCode = 1103
 	SuccessMonitorUpdated    StatusCode = 1104
 	SuccessMonitorFetched    StatusCode = 1105
+	SuccessMonitorTriggered  StatusCode = 1106
+	SuccessPingReceived      StatusCode = 1107
 
 	// --- Auth Success (1200~)
 	SuccessUserRegistered   StatusCode = 1201
@@ -24,6 +26,24 @@ const (
 	SuccessPasswordVerified StatusCode = 1208
 	SuccessLoggedOut        StatusCode = 1209
 
+	// --- Log Success (1300~)
+	SuccessHealthLogListed       StatusCode = 1301
+	SuccessMonitorStatusListed   StatusCode = 1302
+	SuccessNotificationLogListed StatusCode = 1303
+
+	// --- Notification Success (1400~)
+	SuccessChannelRegistered StatusCode = 1401
+	SuccessChannelListed     StatusCode = 1402
+	SuccessChannelFetched    StatusCode = 1403
+	SuccessChannelUpdated    StatusCode = 1404
+	SuccessChannelDeleted    StatusCode = 1405
+	SuccessChannelTested     StatusCode = 1406
+	SuccessChannelAttached   StatusCode = 1407
+	SuccessChannelDetached   StatusCode = 1408
+
+	// --- Incident Success (1500~)
+	SuccessIncidentAcknowledged StatusCode = 1501
+
 	//  Client Error Codes (4xxx)
 	ErrorBadRequest       StatusCode = 4000
 	ErrorValidationFailed StatusCode = 4001
@@ -36,6 +56,7 @@ const (
 	ErrorMonitorDeleteFailed  StatusCode = 4105
 	ErrorMonitorFetchFailed   StatusCode = 4106
 	ErrorPermissionDenied     StatusCode = 4107
+	ErrorMonitorInactive      StatusCode = 4108
 
 	// --- Auth Errors (4200~)
 	ErrorUserNotFound       StatusCode = 4201
@@ -44,35 +65,69 @@ const (
 	ErrorInactiveAccount    StatusCode = 4204
 	ErrorInvalidCredentials StatusCode = 4205
 
+	// --- Log Errors (4300~)
+	ErrorInvalidLogQuery StatusCode = 4301
+	ErrorInvalidCursor   StatusCode = 4302
+
 	// Auth & Rate Limit (4400~)
 	ErrorUnauthorized      StatusCode = 4400
 	ErrorRateLimitExceeded StatusCode = 4403
 
+	// --- Notification Errors (4500~)
+	ErrorChannelNotFound     StatusCode = 4501
+	ErrorInvalidChannel      StatusCode = 4502
+	ErrorUnsupportedChannel  StatusCode = 4503
+	ErrorChannelUpdateFailed StatusCode = 4504
+	ErrorChannelDeleteFailed StatusCode = 4505
+
+	// --- Incident Errors (4600~)
+	ErrorIncidentNotFound     StatusCode = 4601
+	ErrorIncidentResolved     StatusCode = 4602
+	ErrorIncidentAcknowledged StatusCode = 4603
+
 	// Server Error Codes (5xxx)
 	ErrorInternalServer StatusCode = 5000
 	ErrorDatabase       StatusCode = 5001
 
 	// --- Monitor Failures (5100~)
 	ErrorMonitorRegisterFailed StatusCode = 5101
+	ErrorMonitorTriggerFailed  StatusCode = 5102
+
+	// --- Notification Failures (5200~)
+	ErrorChannelRegisterFailed StatusCode = 5201
 )
 
 var messageMap = map[StatusCode]string{
 	// Success
-	Success:                  "요청이 성공적으로 처리되었습니다.",
-	SuccessMonitorRegistered: "모니터링 항목이 성공적으로 등록되었습니다.",
-	SuccessMonitorListed:     "모니터링 목록 조회 성공.",
-	SuccessMonitorDeleted:    "모니터링 항목이 성공적으로 삭제되었습니다.",
-	SuccessMonitorUpdated:    "모니터링 항목이 성공적으로 수정되었습니다.",
-	SuccessMonitorFetched:    "모니터링 상세 정보 조회 성공.",
-	SuccessUserRegistered:    "회원가입이 완료되었습니다.",
-	SuccessUserLoggedIn:      "로그인 성공.",
-	SuccessUserFetched:       "사용자 정보 조회 성공.",
-	SuccessUserUpdated:       "사용자 정보가 성공적으로 수정되었습니다.",
-	SuccessUserResigned:      "회원 탈퇴가 완료되었습니다.",
-	SuccessPasswordChanged:   "비밀번호가 성공적으로 변경되었습니다.",
-	SuccessDuplicateChecked:  "이메일 중복 확인 완료.",
-	SuccessPasswordVerified:  "비밀번호가 확인되었습니다.",
-	SuccessLoggedOut:         "로그아웃 되었습니다. 클라이언트에서 토큰을 삭제해주세요.",
+	Success:                      "요청이 성공적으로 처리되었습니다.",
+	SuccessMonitorRegistered:     "모니터링 항목이 성공적으로 등록되었습니다.",
+	SuccessMonitorListed:         "모니터링 목록 조회 성공.",
+	SuccessMonitorDeleted:        "모니터링 항목이 성공적으로 삭제되었습니다.",
+	SuccessMonitorUpdated:        "모니터링 항목이 성공적으로 수정되었습니다.",
+	SuccessMonitorFetched:        "모니터링 상세 정보 조회 성공.",
+	SuccessMonitorTriggered:      "모니터링 수동 검사가 완료되었습니다.",
+	SuccessPingReceived:          "하트비트 핑이 기록되었습니다.",
+	SuccessUserRegistered:        "회원가입이 완료되었습니다.",
+	SuccessUserLoggedIn:          "로그인 성공.",
+	SuccessUserFetched:           "사용자 정보 조회 성공.",
+	SuccessUserUpdated:           "사용자 정보가 성공적으로 수정되었습니다.",
+	SuccessUserResigned:          "회원 탈퇴가 완료되었습니다.",
+	SuccessPasswordChanged:       "비밀번호가 성공적으로 변경되었습니다.",
+	SuccessDuplicateChecked:      "이메일 중복 확인 완료.",
+	SuccessPasswordVerified:      "비밀번호가 확인되었습니다.",
+	SuccessLoggedOut:             "로그아웃 되었습니다. 클라이언트에서 토큰을 삭제해주세요.",
+	SuccessHealthLogListed:       "헬스 로그 조회 성공.",
+	SuccessMonitorStatusListed:   "모니터링 상태 조회 성공.",
+	SuccessNotificationLogListed: "알림 전송 이력 조회 성공.",
+	SuccessChannelRegistered:     "알림 채널이 성공적으로 등록되었습니다.",
+	SuccessChannelListed:         "알림 채널 목록 조회 성공.",
+	SuccessChannelFetched:        "알림 채널 상세 정보 조회 성공.",
+	SuccessChannelUpdated:        "알림 채널이 성공적으로 수정되었습니다.",
+	SuccessChannelDeleted:        "알림 채널이 성공적으로 삭제되었습니다.",
+	SuccessChannelTested:         "테스트 알림 전송 결과입니다.",
+	SuccessChannelAttached:       "모니터에 알림 채널이 연결되었습니다.",
+	SuccessChannelDetached:       "모니터에서 알림 채널 연결이 해제되었습니다.",
+	SuccessIncidentAcknowledged:  "인시던트 확인이 기록되었습니다.",
 
 	// Client Errors
 	ErrorBadRequest:           "잘못된 요청입니다.",
@@ -84,20 +139,35 @@ var messageMap = map[StatusCode]string{
 	ErrorMonitorDeleteFailed:  "모니터링 삭제에 실패했습니다.",
 	ErrorMonitorFetchFailed:   "모니터링 상세 조회에 실패했습니다.",
 	ErrorPermissionDenied:     "요청에 대한 권한이 없습니다.",
+	ErrorMonitorInactive:      "비활성화된 모니터링 항목입니다.",
 	ErrorUserNotFound:         "해당 사용자를 찾을 수 없습니다.",
 	ErrorEmailAlreadyExists:   "이미 사용 중인 이메일입니다.",
 	ErrorPasswordMismatch:     "비밀번호가 일치하지 않습니다.",
 	ErrorInactiveAccount:      "비활성화된 계정입니다. 관리자에게 문의해주세요.",
 	ErrorInvalidCredentials:   "이메일 또는 비밀번호가 올바르지 않습니다.",
+	ErrorInvalidLogQuery:      "로그 조회 조건이 올바르지 않습니다.",
+	ErrorInvalidCursor:        "유효하지 않은 커서입니다.",
 
 	// Auth / Rate Limit
 	ErrorUnauthorized:      "인증이 필요합니다.",
 	ErrorRateLimitExceeded: "요청이 너무 많습니다. 잠시 후 다시 시도해주세요.",
 
+	// Notification Errors
+	ErrorChannelNotFound:      "해당 알림 채널을 찾을 수 없습니다.",
+	ErrorInvalidChannel:       "알림 채널 설정이 올바르지 않습니다.",
+	ErrorUnsupportedChannel:   "지원하지 않는 알림 채널입니다.",
+	ErrorChannelUpdateFailed:  "알림 채널 수정에 실패했습니다.",
+	ErrorChannelDeleteFailed:  "알림 채널 삭제에 실패했습니다.",
+	ErrorIncidentNotFound:     "해당 인시던트를 찾을 수 없습니다.",
+	ErrorIncidentResolved:     "이미 복구된 인시던트입니다.",
+	ErrorIncidentAcknowledged: "이미 확인된 인시던트입니다.",
+
 	// Server Errors
 	ErrorInternalServer:        "서버 내부 오류가 발생했습니다.",
 	ErrorDatabase:              "데이터베이스 오류가 발생했습니다.",
 	ErrorMonitorRegisterFailed: "모니터링 등록 중 오류가 발생했습니다.",
+	ErrorMonitorTriggerFailed:  "모니터링 수동 검사 중 오류가 발생했습니다.",
+	ErrorChannelRegisterFailed: "알림 채널 등록 중 오류가 발생했습니다.",
 }
 
 func GetMessage(code StatusCode) string {
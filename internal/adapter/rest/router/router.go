@@ -2,13 +2,8 @@ package router
 
 import (
 	"context"
-	"keeplo/internal/adapter/repository/monitor_repo"
-	"keeplo/internal/adapter/repository/user_repo"
 	"keeplo/internal/adapter/rest/handler"
 	"keeplo/internal/adapter/rest/middleware"
-	"keeplo/internal/application/monitor"
-	"keeplo/internal/application/user"
-	"keeplo/pkg/db/postgresql"
 	"net/http"
 	"time"
 
@@ -19,7 +14,7 @@ import (
 	ginSwagger "github.com/swaggo/gin-swagger"
 )
 
-func Run(ctx context.Context) {
+func Run(ctx context.Context, handlerService *handler.Handler) {
 	r := gin.Default()
 	api := r.Group("/api/v1")
 	// cors
@@ -29,14 +24,6 @@ func Run(ctx context.Context) {
 
 	// https
 
-	// --- TEMP
-	userRepo := user_repo.NewGormUserRepo(postgresql.GetDB())
-	monitorRepo := monitor_repo.NewGormMonitorRepo(postgresql.GetDB())
-	userService := user.NewUserService(userRepo)
-	monitorService := monitor.NewMonitorService(monitorRepo, userRepo)
-	handlerService := handler.NewHandler(userService, monitorService)
-	// --- TEMP
-
 	api.GET("/me", middleware.AuthMiddleware(), func(c *gin.Context) {
 		userID, ok := c.Get(middleware.ContextUserIDKey)
 		if !ok {
@@ -49,6 +36,9 @@ func Run(ctx context.Context) {
 	registerUserHandler(api, handlerService)
 	registerMonitorHandler(api, handlerService)
 	registerLogHandler(api, handlerService)
+	registerNotificationHandler(api, handlerService)
+	registerIncidentHandler(api, handlerService)
+	registerPingHandler(api, handlerService)
 
 	srv := &http.Server{
 		Addr:              ":8888",
@@ -86,7 +76,7 @@ func registerUserHandler(api *gin.RouterGroup, handlerService *handler.Handler)
 }
 
 func registerMonitorHandler(api *gin.RouterGroup, handlerService *handler.Handler) {
-	monitor := api.Group("/monitor")
+	monitor := api.Group("/monitor", middleware.AuthMiddleware())
 
 	monitor.POST("", handlerService.RegisterMonitorHandler)     // 모니터링 주소 추가
 	monitor.GET("/list", handlerService.GetMonitorListHandler)  // 모니터 목록 조회
@@ -103,10 +93,43 @@ func registerMonitorHandler(api *gin.RouterGroup, handlerService *handler.Handle
 func registerLogHandler(api *gin.RouterGroup, handlerService *handler.Handler) {
 	logg := api.Group("/log", middleware.AuthMiddleware())
 
-	logg.GET("/status")                                                  // 상태 로그
-	logg.GET("/health")                                                  // 전체 헬스 로그
-	logg.GET("/health/:monitor_id", handlerService.GetHealthLogsHandler) // 모니터별 헬스 로그
+	logg.GET("/status", handlerService.GetMonitorStatusHandler)                       // 상태 로그
+	logg.GET("/health", handlerService.GetAllHealthLogsHandler)                       // 전체 헬스 로그
+	logg.GET("/health/:monitor_id", handlerService.GetHealthLogsHandler)              // 모니터별 헬스 로그
+	logg.GET("/notifications/:monitor_id", handlerService.GetNotificationLogsHandler) // 알림 이력
 	// logg.GET("/health/:monitor_id/errors", handlerService.GetHealthErrorSummaryHandler)    // 실패 요약
 	// logg.GET("/health/:monitor_id/timeseries", handlerService.GetResponseTimeChartHandler) // 응답 시간 그래프
-	// logg.GET("/notifications/:monitor_id", handlerService.GetNotificationLogsHandler)      // 알림 이력
+}
+
+func registerNotificationHandler(api *gin.RouterGroup, handlerService *handler.Handler) {
+	noti := api.Group("/notifications", middleware.AuthMiddleware())
+
+	noti.POST("", handlerService.RegisterChannelHandler)      // 알림 채널 추가
+	noti.GET("", handlerService.GetChannelListHandler)        // 알림 채널 목록 조회
+	noti.GET("/:id", handlerService.GetChannelHandler)        // 단일 알림 채널 조회
+	noti.PUT("/:id", handlerService.UpdateChannelHandler)     // 알림 채널 수정
+	noti.DELETE("/:id", handlerService.RemoveChannelHandler)  // 알림 채널 삭제
+	noti.POST("/:id/test", handlerService.TestChannelHandler) // 테스트 알림 전송
+
+	noti.PUT("/:id/monitors/:monitor_id", handlerService.AttachChannelHandler)    // 모니터에 채널 연결
+	noti.DELETE("/:id/monitors/:monitor_id", handlerService.DetachChannelHandler) // 모니터 채널 연결 해제
+}
+
+func registerIncidentHandler(api *gin.RouterGroup, handlerService *handler.Handler) {
+	incident := api.Group("/incident", middleware.AuthMiddleware())
+
+	incident.PATCH("/:id/acknowledge", handlerService.AcknowledgeIncidentHandler) // 장애 확인
+}
+
+// 작업에서 인증 없이 호출하는 하트비트 수신 엔드포인트. 토큰이 곧 인증 수단
+func registerPingHandler(api *gin.RouterGroup, handlerService *handler.Handler) {
+	ping := api.Group("/ping")
+
+	// 성공 핑은 curl, wget 등 단순 호출을 위해 GET, HEAD 도 허용
+	// 시작/실패는 상태를 바꾸므로 링크 미리보기나 크롤러가 건드리지 않도록 POST 만 허용
+	for _, method := range []string{http.MethodGet, http.MethodPost, http.MethodHead} {
+		ping.Handle(method, "/:token", handlerService.PingSuccessHandler) // 작업 성공
+	}
+	ping.POST("/:token/start", handlerService.PingStartHandler) // 작업 시작
+	ping.POST("/:token/fail", handlerService.PingFailHandler)   // 작업 실패
 }
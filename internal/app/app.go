@@ -3,11 +3,22 @@ package app
 import (
 	"context"
 	"keeplo/config"
+	"keeplo/internal/adapter/notifier"
+	"keeplo/internal/adapter/repository/incident_repo"
+	"keeplo/internal/adapter/repository/monitor_repo"
+	"keeplo/internal/adapter/repository/notification_repo"
+	"keeplo/internal/adapter/repository/user_repo"
+	"keeplo/internal/adapter/rest/handler"
 	"keeplo/internal/adapter/rest/router"
+	"keeplo/internal/application/monitor"
+	"keeplo/internal/application/notification"
+	"keeplo/internal/application/user"
+	notificationDomain "keeplo/internal/domain/notification"
 	"keeplo/internal/scheduler"
 	"keeplo/pkg/auth"
 	"keeplo/pkg/db/postgresql"
 	"keeplo/pkg/logger"
+	"keeplo/pkg/secret"
 	"os"
 	"os/signal"
 	"syscall"
@@ -33,13 +44,46 @@ func Run() {
 
 	// go listenForShutdown(cancel)
 
+	secretBox, err := secret.NewBox(config.AppConfig.SecretKey)
+	if err != nil {
+		logger.Log.Fatal("failed to init secret box", zap.Error(err))
+	}
+	if config.AppConfig.SecretKey == "" {
+		logger.Log.Warn("SECRET_KEY is not set; monitors with credentials and channels with secrets cannot be saved")
+	}
+
+	userRepo := user_repo.NewGormUserRepo(postgresql.GetDB())
+	monitorRepo := monitor_repo.NewGormMonitorRepo(postgresql.GetDB(), secretBox)
+	healthLogRepo := monitor_repo.NewGormHealthLogRepo(postgresql.GetDB())
+	incidentRepo := incident_repo.NewGormIncidentRepo(postgresql.GetDB())
+	channelRepo := notification_repo.NewGormChannelRepo(postgresql.GetDB(), secretBox)
+	deliveryRepo := notification_repo.NewGormDeliveryRepo(postgresql.GetDB())
+
+	dispatcher := notification.NewDispatcher(channelRepo, deliveryRepo, map[string]notificationDomain.Notifier{
+		notificationDomain.ChannelWebhook:  notifier.NewWebhookNotifier(),
+		notificationDomain.ChannelEmail:    notifier.NewEmailNotifier(config.AppConfig.SMTP, userRepo),
+		notificationDomain.ChannelSlack:    notifier.NewSlackNotifier(),
+		notificationDomain.ChannelDiscord:  notifier.NewDiscordNotifier(),
+		notificationDomain.ChannelTelegram: notifier.NewTelegramNotifier(),
+	})
+
+	userService := user.NewUserService(userRepo)
+	monitorService := monitor.NewMonitorService(monitorRepo, healthLogRepo, incidentRepo, userRepo, dispatcher)
+	notificationService := notification.NewNotificationService(monitorRepo, channelRepo, deliveryRepo, userRepo, dispatcher)
+
 	scheduler.NewScheduler()
 	scheduler.AddQueue("health", scheduler.NewInMemoryQueue())
-	start(ctx)
+
+	// 재시작 시 기존 모니터를 스케줄러에 복구
+	if _, err := monitorService.ScheduleEnabledMonitors(ctx); err != nil {
+		logger.Log.Error("failed to restore monitor schedules", zap.Error(err))
+	}
+
+	start(ctx, handler.NewHandler(userService, monitorService, notificationService))
 }
 
-func start(ctx context.Context) {
-	router.Run(ctx)
+func start(ctx context.Context, handlerService *handler.Handler) {
+	router.Run(ctx, handlerService)
 }
 
 func listenForShutdown(cancelFunc context.CancelFunc) {
@@ -4,36 +4,81 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"keeplo/internal/domain/incident"
 	"keeplo/internal/domain/monitor"
+	"keeplo/internal/domain/notification"
+	"keeplo/internal/scheduler"
 	"keeplo/pkg/checker"
 	"keeplo/pkg/logger"
+	"time"
 
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
-type MonitorExecutor struct{}
+// 모니터에 타임아웃이 지정되지 않았을 때 체크 한 번에 허용하는 시간
+const defaultCheckTimeout = 10 * time.Second
 
-func (e *MonitorExecutor) Execute(ctx context.Context, payload any) error {
-	log := logger.WithContext(ctx)
+// 인시던트 상태 변화를 알림 채널로 전달
+type EventPublisher interface {
+	Publish(ctx context.Context, ev *notification.Event)
+}
+
+type MonitorExecutor struct {
+	monitorRepo   monitor.Repository
+	healthLogRepo monitor.HealthLogRepository
+	incidentRepo  incident.Repository
+	publisher     EventPublisher
+}
 
+func NewMonitorExecutor(mRepo monitor.Repository, hRepo monitor.HealthLogRepository, iRepo incident.Repository, publisher EventPublisher) *MonitorExecutor {
+	return &MonitorExecutor{
+		monitorRepo:   mRepo,
+		healthLogRepo: hRepo,
+		incidentRepo:  iRepo,
+		publisher:     publisher,
+	}
+}
+
+func (e *MonitorExecutor) Execute(ctx context.Context, payload any) error {
 	m, ok := payload.(*monitor.Monitor)
 	if !ok {
 		return errors.New("invalid payload type: expected *Monitor")
 	}
 
-	var c checker.Checker
-	switch m.Type {
-	case "HTTP", "HTTPS":
-		c = &checker.HTTPChecker{}
-	case "TCP":
-		c = &checker.TCPChecker{}
-	case "WebSocket":
-		c = &checker.WSChecker{}
-	default:
-		return fmt.Errorf("unsupported protocol: %s", m.Type)
+	l, err := e.Run(ctx, m)
+	if err != nil {
+		return err
+	}
+	if l == nil {
+		return nil
+	}
+	if l.Status == monitor.StatusDown {
+		return fmt.Errorf("check failed: %s", l.Message)
+	}
+	return nil
+}
+
+// 체크를 수행하고 결과를 헬스 로그로 저장
+// 체크 실패는 HealthLog 의 Status, Message 로 전달되고 error 는 체크를 실행하지 못했거나 저장에 실패한 경우만 반환
+// 하트비트처럼 새로 남길 결과가 없으면 HealthLog 와 error 모두 nil
+func (e *MonitorExecutor) Run(ctx context.Context, m *monitor.Monitor) (*monitor.HealthLog, error) {
+	log := logger.WithContext(ctx)
+
+	if m.IsHeartbeat() {
+		return e.checkHeartbeat(ctx, m)
 	}
 
-	result, err := c.Check(ctx, m.Target)
+	c, err := newChecker(m)
+	if err != nil {
+		return nil, err
+	}
+
+	checkCtx, cancel := context.WithTimeout(ctx, checkTimeout(m))
+	defer cancel()
+
+	checkedAt := time.Now()
+	result, err := c.Check(checkCtx, m.Target)
 	if err != nil {
 		log.Warn("MonitorExecutor - check failed", zap.String("monitor_id", m.ID.String()), zap.Error(err))
 	} else {
@@ -44,6 +89,137 @@ func (e *MonitorExecutor) Execute(ctx context.Context, payload any) error {
 		)
 	}
 
-	// TODO: 로깅, 알림 전송 등
-	return err
+	return e.record(ctx, m, newHealthLog(m, result, err, checkedAt))
+}
+
+// 헬스 로그를 저장하고 인시던트 상태를 갱신. 인시던트가 열리거나 해소되면 알림 발행
+func (e *MonitorExecutor) record(ctx context.Context, m *monitor.Monitor, l *monitor.HealthLog) (*monitor.HealthLog, error) {
+	log := logger.WithContext(ctx)
+
+	applyDegradedThreshold(m, l)
+	if err := e.saveHealthLog(ctx, m, l); err != nil {
+		log.Error("MonitorExecutor - failed to save health log", zap.String("monitor_id", m.ID.String()), zap.Error(err))
+		return l, err
+	}
+
+	changed, previous, err := e.updateIncident(ctx, m, l)
+	if err != nil {
+		log.Error("MonitorExecutor - failed to update incident", zap.String("monitor_id", m.ID.String()), zap.Error(err))
+		return l, err
+	}
+	if changed != nil {
+		log.Info("MonitorExecutor - incident status changed",
+			zap.String("monitor_id", m.ID.String()),
+			zap.String("incident_id", changed.ID.String()),
+			zap.String("status", changed.Status),
+		)
+		if e.publisher != nil {
+			e.publisher.Publish(ctx, newIncidentEvent(m, l, changed, previous))
+		}
+	}
+
+	return l, nil
+}
+
+// 실행 직전에 모니터를 다시 조회해서 수정 사항을 반영
+// 삭제되었거나 비활성화된 모니터는 스케줄에서 제외
+func (e *MonitorExecutor) Refresh(ctx context.Context, task *scheduler.Task) (*scheduler.Task, error) {
+	m, err := e.monitorRepo.FindByID(ctx, task.ID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, scheduler.ErrTaskCanceled
+		}
+		return nil, err
+	}
+	if !m.Enabled || m.IntervalSeconds <= 0 {
+		return nil, scheduler.ErrTaskCanceled
+	}
+
+	refreshed := *task
+	refreshed.Payload = m
+	refreshed.Interval = taskInterval(m)
+	return &refreshed, nil
+}
+
+// 레지스트리에서 모니터 종류의 checker 정의를 찾아 설정 블록으로 생성
+func newChecker(m *monitor.Monitor) (checker.Checker, error) {
+	def, ok := checker.Lookup(m.Type)
+	if !ok || def.New == nil {
+		return nil, fmt.Errorf("%w: %s", monitor.ErrUnsupportedProtocol, m.Type)
+	}
+
+	return def.New(checker.Config{Settings: m.Settings.Block(def.Settings), Timeout: checkTimeout(m)})
+}
+
+func checkTimeout(m *monitor.Monitor) time.Duration {
+	if m.TimeoutSeconds > 0 {
+		return time.Duration(m.TimeoutSeconds) * time.Second
+	}
+	return defaultCheckTimeout
+}
+
+// 체크 결과를 헬스 로그로 저장하고 모니터의 마지막 체크 시각을 갱신
+func (e *MonitorExecutor) saveHealthLog(ctx context.Context, m *monitor.Monitor, l *monitor.HealthLog) error {
+	if err := e.healthLogRepo.Create(ctx, l); err != nil {
+		return err
+	}
+	if err := e.monitorRepo.UpdateLastCheckedAt(ctx, m.ID.String(), l.Timestamp); err != nil {
+		return err
+	}
+	m.LastCheckedAt = &l.Timestamp
+	return nil
+}
+
+// checker 가 실패 시 nil 결과를 돌려주는 경우도 있으므로 에러 메시지로 보완
+func newHealthLog(m *monitor.Monitor, result *checker.CheckResult, err error, checkedAt time.Time) *monitor.HealthLog {
+	l := &monitor.HealthLog{
+		MonitorID: m.ID.String(),
+		Status:    monitor.StatusDown,
+		Timestamp: checkedAt,
+	}
+	if result != nil {
+		l.Status = result.Status
+		l.Message = result.Message
+		l.ResponseMs = result.ResponseMs
+		l.Timing = toCheckTiming(result.Timing)
+	}
+	if err != nil {
+		l.Status = monitor.StatusDown
+		if l.Message == "" {
+			l.Message = err.Error()
+		}
+	}
+	if result == nil && err == nil {
+		l.Message = "empty check result"
+	}
+	return l
+}
+
+// 정상 응답이라도 모니터의 응답 시간 기준을 넘으면 degraded 로 낮춤
+func applyDegradedThreshold(m *monitor.Monitor, l *monitor.HealthLog) {
+	if m.DegradedMs <= 0 || l.Status != monitor.StatusUp || l.ResponseMs <= m.DegradedMs {
+		return
+	}
+	l.Status = monitor.StatusDegraded
+	msg := fmt.Sprintf("response time %dms exceeded %dms", l.ResponseMs, m.DegradedMs)
+	if l.Message != "" {
+		msg = l.Message + "; " + msg
+	}
+	l.Message = msg
+}
+
+func toCheckTiming(t *checker.Timing) *monitor.CheckTiming {
+	if t == nil {
+		return nil
+	}
+	return &monitor.CheckTiming{
+		DNSMs:       t.DNSMs,
+		ConnectMs:   t.ConnectMs,
+		TLSMs:       t.TLSMs,
+		FirstByteMs: t.FirstByteMs,
+		TransferMs:  t.TransferMs,
+		RemoteIP:    t.RemoteIP,
+		StatusCode:  t.StatusCode,
+		SizeBytes:   t.SizeBytes,
+	}
 }
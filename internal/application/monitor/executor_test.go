@@ -0,0 +1,201 @@
+package monitor
+
+import (
+	"context"
+	"keeplo/internal/domain/incident"
+	"keeplo/internal/domain/monitor"
+	"keeplo/internal/domain/notification"
+	"keeplo/pkg/logger"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// 생성, ID 조회, 수정, 핑 상태 갱신만 구현한 모니터 저장소
+type memMonitorRepo struct {
+	monitor.Repository
+	monitors map[string]*monitor.Monitor
+}
+
+func (r *memMonitorRepo) Create(ctx context.Context, m *monitor.Monitor) error {
+	copied := *m
+	r.monitors[m.ID.String()] = &copied
+	return nil
+}
+
+func (r *memMonitorRepo) FindByID(ctx context.Context, id string) (*monitor.Monitor, error) {
+	m, ok := r.monitors[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	copied := *m
+	return &copied, nil
+}
+
+func (r *memMonitorRepo) Update(ctx context.Context, m *monitor.Monitor) error {
+	copied := *m
+	r.monitors[m.ID.String()] = &copied
+	return nil
+}
+
+func (r *memMonitorRepo) UpdatePingState(ctx context.Context, id string, lastPingAt, runStartedAt *time.Time) error {
+	m, ok := r.monitors[id]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	m.LastPingAt, m.RunStartedAt = lastPingAt, runStartedAt
+	return nil
+}
+
+func (r *memMonitorRepo) UpdateLastCheckedAt(ctx context.Context, id string, checkedAt time.Time) error {
+	return nil
+}
+
+// 저장 순서대로 쌓고 최신순으로 조회하는 헬스 로그 저장소
+type memHealthLogRepo struct {
+	mu   sync.Mutex
+	logs []*monitor.HealthLog
+}
+
+func (r *memHealthLogRepo) Create(ctx context.Context, l *monitor.HealthLog) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l.ID = uuid.NewString()
+	r.logs = append(r.logs, l)
+	return nil
+}
+
+func (r *memHealthLogRepo) Find(ctx context.Context, q monitor.HealthLogQuery) ([]*monitor.HealthLog, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*monitor.HealthLog
+	for i := len(r.logs) - 1; i >= 0 && len(out) < q.Limit; i-- {
+		out = append(out, r.logs[i])
+	}
+	return out, nil
+}
+
+func (r *memHealthLogRepo) FindLatestByMonitorIDs(ctx context.Context, monitorIDs []string) ([]*monitor.HealthLog, error) {
+	return nil, nil
+}
+
+func (r *memHealthLogRepo) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.logs)
+}
+
+type memIncidentRepo struct {
+	mu        sync.Mutex
+	incidents []*incident.Incident
+}
+
+// 저장소의 부분 유니크 인덱스처럼 모니터마다 열린 인시던트는 하나만 허용
+func (r *memIncidentRepo) Create(ctx context.Context, i *incident.Incident) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, saved := range r.incidents {
+		if saved.MonitorID == i.MonitorID && saved.IsActive() {
+			return incident.ErrIncidentAlreadyOpen
+		}
+	}
+	copied := *i
+	r.incidents = append(r.incidents, &copied)
+	return nil
+}
+
+func (r *memIncidentRepo) Update(ctx context.Context, i *incident.Incident) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for n, saved := range r.incidents {
+		if saved.ID == i.ID {
+			copied := *i
+			r.incidents[n] = &copied
+			return nil
+		}
+	}
+	return gorm.ErrRecordNotFound
+}
+
+func (r *memIncidentRepo) FindByID(ctx context.Context, id string) (*incident.Incident, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, i := range r.incidents {
+		if i.ID.String() == id {
+			copied := *i
+			return &copied, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *memIncidentRepo) FindActiveByMonitorID(ctx context.Context, monitorID string) (*incident.Incident, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, i := range r.incidents {
+		if i.MonitorID.String() == monitorID && i.IsActive() {
+			copied := *i
+			return &copied, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+type recordingPublisher struct {
+	mu     sync.Mutex
+	events []*notification.Event
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, ev *notification.Event) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, ev)
+}
+
+func newTestExecutor(t *testing.T) (*MonitorExecutor, *memHealthLogRepo, *memIncidentRepo, *recordingPublisher) {
+	t.Helper()
+	logger.Log = zap.NewNop()
+
+	logs, incidents, publisher := &memHealthLogRepo{}, &memIncidentRepo{}, &recordingPublisher{}
+	return NewMonitorExecutor(&memMonitorRepo{}, logs, incidents, publisher), logs, incidents, publisher
+}
+
+func TestMonitorExecutor_HeartbeatWithinDeadline(t *testing.T) {
+	e, logs, _, _ := newTestExecutor(t)
+	m := &monitor.Monitor{ID: uuid.New(), Type: monitor.TypeHeartbeat, IntervalSeconds: 60, CreatedAt: time.Now()}
+
+	l, err := e.Run(context.Background(), m)
+	require.NoError(t, err)
+	assert.Nil(t, l)
+	assert.NoError(t, e.Execute(context.Background(), m))
+	assert.Zero(t, logs.count())
+}
+
+func TestMonitorExecutor_HeartbeatMissedOncePerPeriod(t *testing.T) {
+	e, logs, _, _ := newTestExecutor(t)
+	m := &monitor.Monitor{
+		ID:               uuid.New(),
+		Type:             monitor.TypeHeartbeat,
+		IntervalSeconds:  60,
+		FailureThreshold: 1,
+		CreatedAt:        time.Now().Add(-90 * time.Second),
+	}
+
+	l, err := e.Run(context.Background(), m)
+	require.NoError(t, err)
+	require.NotNil(t, l)
+	assert.NotEmpty(t, l.ID)
+	assert.Equal(t, monitor.StatusDown, l.Status)
+
+	// 같은 주기 안의 다음 실행은 새 결과가 없으므로 스케줄러에 실패로 보고하지 않음
+	for range 3 {
+		assert.NoError(t, e.Execute(context.Background(), m))
+	}
+	assert.Equal(t, 1, logs.count())
+}
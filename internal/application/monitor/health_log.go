@@ -0,0 +1,208 @@
+package monitor
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"keeplo/internal/adapter/rest/dto"
+	"keeplo/internal/domain/monitor"
+	"keeplo/pkg/logger"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultHealthLogLimit = 50
+	maxHealthLogLimit     = 200
+)
+
+func (m *monitorService) SearchHealthLogs(ctx context.Context, monitorID, userID string, req dto.HealthLogQueryRequest) ([]*monitor.HealthLog, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, monitorTimeout)
+	defer cancel()
+
+	log := logger.WithContext(ctx)
+	log.Debug("SearchHealthLogs - called", zap.String("monitor_id", monitorID), zap.String("user_id", userID))
+
+	// UUID 가 아닌 ID 는 DB 에서 형변환 오류가 나므로 조회 전에 걸러냄
+	if _, err := uuid.Parse(monitorID); err != nil {
+		log.Warn("SearchHealthLogs - invalid monitor id", zap.String("monitor_id", monitorID))
+		return nil, "", monitor.ErrMonitorNotFound
+	}
+
+	monitorObj, err := m.monitorRepo.FindByID(ctx, monitorID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Warn("SearchHealthLogs - monitor not found", zap.String("monitor_id", monitorID))
+			return nil, "", monitor.ErrMonitorNotFound
+		}
+		log.Error("SearchHealthLogs - fetch monitor failed", zap.Error(err))
+		return nil, "", err
+	}
+	if monitorObj.UserID.String() != userID {
+		log.Warn("SearchHealthLogs - permission denied", zap.String("monitor_id", monitorID), zap.String("user_id", userID))
+		return nil, "", monitor.ErrPermissionDenied
+	}
+
+	logs, next, err := m.findHealthLogs(ctx, []string{monitorID}, req)
+	if err != nil {
+		log.Warn("SearchHealthLogs - failed", zap.String("monitor_id", monitorID), zap.Error(err))
+		return nil, "", err
+	}
+
+	log.Info("SearchHealthLogs - success", zap.String("monitor_id", monitorID), zap.Int("count", len(logs)))
+	return logs, next, nil
+}
+
+func (m *monitorService) SearchUserHealthLogs(ctx context.Context, userID string, req dto.HealthLogQueryRequest) ([]*monitor.HealthLog, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, monitorTimeout)
+	defer cancel()
+
+	log := logger.WithContext(ctx)
+	log.Debug("SearchUserHealthLogs - called", zap.String("user_id", userID))
+
+	monitors, err := m.monitorRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		log.Error("SearchUserHealthLogs - fetch monitors failed", zap.Error(err))
+		return nil, "", err
+	}
+
+	ids := make([]string, 0, len(monitors))
+	for _, mo := range monitors {
+		ids = append(ids, mo.ID.String())
+	}
+
+	logs, next, err := m.findHealthLogs(ctx, ids, req)
+	if err != nil {
+		log.Warn("SearchUserHealthLogs - failed", zap.String("user_id", userID), zap.Error(err))
+		return nil, "", err
+	}
+
+	log.Info("SearchUserHealthLogs - success", zap.String("user_id", userID), zap.Int("count", len(logs)))
+	return logs, next, nil
+}
+
+func (m *monitorService) SearchMonitorStatuses(ctx context.Context, userID string) ([]*monitor.MonitorStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, monitorTimeout)
+	defer cancel()
+
+	log := logger.WithContext(ctx)
+	log.Debug("SearchMonitorStatuses - called", zap.String("user_id", userID))
+
+	monitors, err := m.monitorRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		log.Error("SearchMonitorStatuses - fetch monitors failed", zap.Error(err))
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(monitors))
+	for _, mo := range monitors {
+		ids = append(ids, mo.ID.String())
+	}
+
+	latest, err := m.healthLogRepo.FindLatestByMonitorIDs(ctx, ids)
+	if err != nil {
+		log.Error("SearchMonitorStatuses - fetch latest logs failed", zap.Error(err))
+		return nil, err
+	}
+
+	byMonitor := make(map[string]*monitor.HealthLog, len(latest))
+	for _, l := range latest {
+		byMonitor[l.MonitorID] = l
+	}
+
+	statuses := make([]*monitor.MonitorStatus, 0, len(monitors))
+	for _, mo := range monitors {
+		statuses = append(statuses, &monitor.MonitorStatus{
+			Monitor: mo,
+			LastLog: byMonitor[mo.ID.String()],
+		})
+	}
+
+	log.Info("SearchMonitorStatuses - success", zap.String("user_id", userID), zap.Int("count", len(statuses)))
+	return statuses, nil
+}
+
+// limit+1 건을 조회해서 다음 페이지 존재 여부를 판단
+func (m *monitorService) findHealthLogs(ctx context.Context, monitorIDs []string, req dto.HealthLogQueryRequest) ([]*monitor.HealthLog, string, error) {
+	q, err := newHealthLogQuery(monitorIDs, req)
+	if err != nil {
+		return nil, "", err
+	}
+
+	limit := q.Limit
+	q.Limit = limit + 1
+	logs, err := m.healthLogRepo.Find(ctx, q)
+	if err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if len(logs) > limit {
+		logs = logs[:limit]
+		last := logs[len(logs)-1]
+		next = encodeCursor(monitor.HealthLogCursor{Timestamp: last.Timestamp, ID: last.ID})
+	}
+	return logs, next, nil
+}
+
+func newHealthLogQuery(monitorIDs []string, req dto.HealthLogQueryRequest) (monitor.HealthLogQuery, error) {
+	q := monitor.HealthLogQuery{
+		MonitorIDs: monitorIDs,
+		Status:     req.Status,
+		From:       req.From,
+		To:         req.To,
+		Limit:      req.Limit,
+	}
+
+	if q.Limit <= 0 {
+		q.Limit = defaultHealthLogLimit
+	}
+	if q.Limit > maxHealthLogLimit {
+		q.Limit = maxHealthLogLimit
+	}
+	if q.From != nil && q.To != nil && !q.From.Before(*q.To) {
+		return q, monitor.ErrInvalidLogQuery
+	}
+
+	if req.Cursor != "" {
+		cursor, err := decodeCursor(req.Cursor)
+		if err != nil {
+			return q, err
+		}
+		q.After = cursor
+	}
+	return q, nil
+}
+
+// 커서 포맷: base64url("<unix nano>|<log id>")
+func encodeCursor(c monitor.HealthLogCursor) string {
+	raw := fmt.Sprintf("%d|%s", c.Timestamp.UnixNano(), c.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(s string) (*monitor.HealthLogCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, monitor.ErrInvalidCursor
+	}
+
+	ts, id, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return nil, monitor.ErrInvalidCursor
+	}
+	nano, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return nil, monitor.ErrInvalidCursor
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, monitor.ErrInvalidCursor
+	}
+
+	return &monitor.HealthLogCursor{Timestamp: time.Unix(0, nano), ID: id}, nil
+}
@@ -0,0 +1,177 @@
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"keeplo/internal/domain/monitor"
+	"keeplo/pkg/checker"
+	"keeplo/pkg/logger"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	// 하트비트 기한 확인 주기의 상한. 주기가 길어도 기한 초과를 늦게 알아채지 않도록 제한
+	maxHeartbeatCheckInterval = time.Minute
+
+	maxPingMessageLength = 500
+)
+
+// 작업이 보낸 핑을 헬스 로그로 기록
+// start 는 실행 시작 시각만 저장하고, success/fail 은 실행 시간과 함께 up/down 로그를 남김
+func (m *monitorService) ReceivePing(ctx context.Context, token, kind, message string) error {
+	ctx, cancel := context.WithTimeout(ctx, triggerTimeout)
+	defer cancel()
+
+	log := logger.WithContext(ctx)
+	mo, err := m.monitorRepo.FindByPingToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Warn("ReceivePing - unknown token")
+			return monitor.ErrMonitorNotFound
+		}
+		log.Error("ReceivePing - fetch failed", zap.Error(err))
+		return err
+	}
+	if !mo.IsHeartbeat() {
+		return monitor.ErrMonitorNotFound
+	}
+	if !mo.Enabled {
+		log.Warn("ReceivePing - monitor disabled", zap.String("monitor_id", mo.ID.String()))
+		return monitor.ErrMonitorInactive
+	}
+
+	now := time.Now()
+	if kind == monitor.PingStart {
+		if err := m.monitorRepo.UpdatePingState(ctx, mo.ID.String(), mo.LastPingAt, &now); err != nil {
+			log.Error("ReceivePing - failed to save start", zap.String("monitor_id", mo.ID.String()), zap.Error(err))
+			return err
+		}
+		log.Info("ReceivePing - run started", zap.String("monitor_id", mo.ID.String()))
+		return nil
+	}
+
+	l := &monitor.HealthLog{
+		MonitorID: mo.ID.String(),
+		Status:    monitor.StatusUp,
+		Timestamp: now,
+	}
+	if mo.RunStartedAt != nil {
+		l.ResponseMs = int(now.Sub(*mo.RunStartedAt).Milliseconds())
+	}
+	if kind == monitor.PingFail {
+		l.Status = monitor.StatusDown
+		l.Message = "job reported failure"
+		if message != "" {
+			l.Message += ": " + truncateMessage(message, maxPingMessageLength)
+		}
+	}
+
+	if err := m.monitorRepo.UpdatePingState(ctx, mo.ID.String(), &now, nil); err != nil {
+		log.Error("ReceivePing - failed to save ping", zap.String("monitor_id", mo.ID.String()), zap.Error(err))
+		return err
+	}
+	mo.LastPingAt, mo.RunStartedAt = &now, nil
+
+	if _, err := m.executor.record(ctx, mo, l); err != nil {
+		return err
+	}
+
+	log.Info("ReceivePing - ping recorded", zap.String("monitor_id", mo.ID.String()), zap.String("kind", kind), zap.Int("ms", l.ResponseMs))
+	return nil
+}
+
+// 마지막 핑 이후 주기 + 유예 시간이 지났으면 놓친 주기마다 down 로그를 한 번씩 남김
+// 기한 안이거나 이번 주기를 이미 기록했으면 새 결과가 없으므로 nil 로그를 반환 (정상 기록은 핑이 남김)
+func (e *MonitorExecutor) checkHeartbeat(ctx context.Context, m *monitor.Monitor) (*monitor.HealthLog, error) {
+	now := time.Now()
+	deadline := heartbeatDeadline(m)
+
+	if now.Before(deadline) {
+		logger.WithContext(ctx).Debug("MonitorExecutor - heartbeat within deadline",
+			zap.String("monitor_id", m.ID.String()),
+			zap.Time("deadline", deadline),
+		)
+		return nil, nil
+	}
+
+	// 기한 이후 로그는 핑이 아니면 이 함수가 남긴 down 로그뿐이므로 마지막 체크 시각으로 중복 판단
+	if m.LastCheckedAt != nil && !m.LastCheckedAt.Before(missedPeriodStart(m, deadline, now)) {
+		return nil, nil
+	}
+
+	msg := "no ping received yet"
+	if m.LastPingAt != nil {
+		msg = fmt.Sprintf("no ping received since %s", m.LastPingAt.Format(time.RFC3339))
+	}
+	if m.RunStartedAt != nil {
+		msg = fmt.Sprintf("job started at %s but did not finish", m.RunStartedAt.Format(time.RFC3339))
+	}
+
+	return e.record(ctx, m, &monitor.HealthLog{
+		MonitorID: m.ID.String(),
+		Status:    monitor.StatusDown,
+		Message:   msg,
+		Timestamp: now,
+	})
+}
+
+// now 가 속한 놓친 주기의 시작 시각. 기한부터 모니터 주기 단위로 나눔
+func missedPeriodStart(m *monitor.Monitor, deadline, now time.Time) time.Time {
+	interval := time.Duration(m.IntervalSeconds) * time.Second
+	if interval <= 0 {
+		return deadline
+	}
+	return deadline.Add(now.Sub(deadline) / interval * interval)
+}
+
+func heartbeatDeadline(m *monitor.Monitor) time.Time {
+	last := m.CreatedAt
+	if m.LastPingAt != nil && m.LastPingAt.After(last) {
+		last = *m.LastPingAt
+	}
+
+	grace := time.Duration(m.Settings.Heartbeat().GraceSeconds) * time.Second
+	return last.Add(time.Duration(m.IntervalSeconds)*time.Second + grace)
+}
+
+// 스케줄러 실행 주기. 하트비트는 대상에 접속하지 않으므로 기한을 촘촘히 확인
+func taskInterval(m *monitor.Monitor) time.Duration {
+	interval := time.Duration(m.IntervalSeconds) * time.Second
+	if m.IsHeartbeat() {
+		return min(interval, maxHeartbeatCheckInterval)
+	}
+	return interval
+}
+
+func truncateMessage(s string, n int) string {
+	r := []rune(s)
+	if len(r) > n {
+		return string(r[:n]) + "..."
+	}
+	return s
+}
+
+// 하트비트는 서버가 직접 체크하지 않으므로 New 없이 이름과 설정만 등록
+func init() {
+	checker.MustRegister(checker.Definition{
+		Name:        monitor.TypeHeartbeat,
+		Description: "작업이 보내는 핑을 주기 + 유예 시간 안에 받는지 확인",
+		Settings:    monitor.HeartbeatSettingsKey,
+		Schema:      monitor.HeartbeatSettings{},
+		Validate: func(raw json.RawMessage) error {
+			var s monitor.HeartbeatSettings
+			if err := checker.DecodeSettings(raw, &s); err != nil {
+				return err
+			}
+			if s.GraceSeconds < 0 || s.GraceSeconds > 86400 {
+				return errors.New("grace_seconds must be between 0 and 86400")
+			}
+			return nil
+		},
+	})
+}
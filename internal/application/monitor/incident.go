@@ -0,0 +1,205 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"keeplo/internal/domain/incident"
+	"keeplo/internal/domain/monitor"
+	"keeplo/internal/domain/notification"
+	"keeplo/pkg/logger"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// 인시던트를 열 때 연속 실패 사이에 끼어 있어도 되는 degraded 로그 수
+const maxDegradedBetweenFailures = 10
+
+// 최근 헬스 로그를 기준으로 인시던트를 열거나 해소
+// degraded 는 인시던트를 열지도, 연속 실패 횟수를 초기화하지도 않음
+// 복구 판단에서는 up 과 같이 정상 응답으로 봄
+// 상태가 바뀐 경우에만 해당 인시던트와 바뀌기 직전의 모니터 상태를 반환
+func (e *MonitorExecutor) updateIncident(ctx context.Context, m *monitor.Monitor, current *monitor.HealthLog) (*incident.Incident, string, error) {
+	failureThreshold := m.FailureThreshold
+	if failureThreshold <= 0 {
+		failureThreshold = monitor.DefaultFailureThreshold
+	}
+	recoveryThreshold := m.RecoveryThreshold
+	if recoveryThreshold <= 0 {
+		recoveryThreshold = monitor.DefaultRecoveryThreshold
+	}
+
+	active, err := e.incidentRepo.FindActiveByMonitorID(ctx, m.ID.String())
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, "", err
+	}
+
+	switch {
+	case active == nil && current.Status == monitor.StatusDown:
+		// 연속 실패 직전 로그까지 읽어서 장애 전 상태 (up 또는 degraded) 를 알림에 전달
+		recent, err := e.recentHealthLogs(ctx, m, failureThreshold+maxDegradedBetweenFailures+1)
+		if err != nil {
+			return nil, "", err
+		}
+		failures, previous, ok := consecutiveFailures(recent, failureThreshold)
+		if !ok {
+			return nil, "", nil
+		}
+		opened := newIncident(m, current, failures)
+		if err := e.incidentRepo.Create(ctx, opened); err != nil {
+			// 스케줄 실행과 수동 실행이 동시에 열려고 하면 먼저 저장한 쪽만 알림
+			if errors.Is(err, incident.ErrIncidentAlreadyOpen) {
+				return nil, "", nil
+			}
+			return nil, "", err
+		}
+		return opened, previous, nil
+
+	case active != nil && current.Status != monitor.StatusDown:
+		recent, err := e.recentHealthLogs(ctx, m, recoveryThreshold)
+		if err != nil {
+			return nil, "", err
+		}
+		if !allStatus(recent, recoveryThreshold, monitor.StatusUp, monitor.StatusDegraded) {
+			return nil, "", nil
+		}
+		if err := active.Resolve(current.Timestamp); err != nil {
+			return nil, "", err
+		}
+		if err := e.incidentRepo.Update(ctx, active); err != nil {
+			return nil, "", err
+		}
+		return active, monitor.StatusDown, nil
+	}
+	return nil, "", nil
+}
+
+// 담당자가 장애를 확인했음을 기록. 모니터 소유자만 가능
+func (m *monitorService) AcknowledgeIncident(ctx context.Context, incidentID, userID string) (*incident.Incident, error) {
+	ctx, cancel := context.WithTimeout(ctx, monitorTimeout)
+	defer cancel()
+
+	log := logger.WithContext(ctx)
+	if _, err := uuid.Parse(incidentID); err != nil {
+		return nil, incident.ErrIncidentNotFound
+	}
+
+	i, err := m.incidentRepo.FindByID(ctx, incidentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, incident.ErrIncidentNotFound
+		}
+		log.Error("AcknowledgeIncident - fetch failed", zap.String("incident_id", incidentID), zap.Error(err))
+		return nil, err
+	}
+
+	mo, err := m.monitorRepo.FindByID(ctx, i.MonitorID.String())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, incident.ErrIncidentNotFound
+		}
+		log.Error("AcknowledgeIncident - monitor fetch failed", zap.String("incident_id", incidentID), zap.Error(err))
+		return nil, err
+	}
+	if mo.UserID.String() != userID {
+		log.Warn("AcknowledgeIncident - permission denied", zap.String("incident_id", incidentID), zap.String("user_id", userID))
+		return nil, monitor.ErrPermissionDenied
+	}
+
+	if err := i.Acknowledge(time.Now()); err != nil {
+		return nil, err
+	}
+	if err := m.incidentRepo.Update(ctx, i); err != nil {
+		log.Error("AcknowledgeIncident - update failed", zap.String("incident_id", incidentID), zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("AcknowledgeIncident - success", zap.String("incident_id", incidentID), zap.String("user_id", userID))
+	return i, nil
+}
+
+// 최신순 헬스 로그 n건
+func (e *MonitorExecutor) recentHealthLogs(ctx context.Context, m *monitor.Monitor, n int) ([]*monitor.HealthLog, error) {
+	return e.healthLogRepo.Find(ctx, monitor.HealthLogQuery{
+		MonitorIDs: []string{m.ID.String()},
+		Limit:      n,
+	})
+}
+
+// 최신순 로그에서 degraded 를 건너뛰고 down 이 n건 연속인지 확인
+// 연속 실패 로그(최신순)와 그 직전 로그의 상태를 반환. 직전 로그가 없으면 unknown
+func consecutiveFailures(logs []*monitor.HealthLog, n int) ([]*monitor.HealthLog, string, bool) {
+	var failures []*monitor.HealthLog
+	for i, l := range logs {
+		switch l.Status {
+		case monitor.StatusDown:
+			failures = append(failures, l)
+		case monitor.StatusDegraded:
+			continue
+		default:
+			return nil, "", false
+		}
+		if len(failures) == n {
+			previous := monitor.StatusUnknown
+			if i+1 < len(logs) {
+				previous = logs[i+1].Status
+			}
+			return failures, previous, true
+		}
+	}
+	return nil, "", false
+}
+
+// 최근 n건이 모두 statuses 중 하나인지
+func allStatus(logs []*monitor.HealthLog, n int, statuses ...string) bool {
+	if len(logs) < n {
+		return false
+	}
+	for _, l := range logs[:n] {
+		if !slices.Contains(statuses, l.Status) {
+			return false
+		}
+	}
+	return true
+}
+
+// recent 는 최신순이므로 오래된 순으로 뒤집어서 저장
+func newIncident(m *monitor.Monitor, current *monitor.HealthLog, recent []*monitor.HealthLog) *incident.Incident {
+	ids := make([]string, 0, len(recent))
+	for i := len(recent) - 1; i >= 0; i-- {
+		ids = append(ids, recent[i].ID)
+	}
+
+	now := time.Now()
+	return &incident.Incident{
+		ID:           uuid.New(),
+		MonitorID:    m.ID,
+		Status:       incident.StatusOpened,
+		Cause:        current.Message,
+		HealthLogIDs: ids,
+		StartedAt:    recent[len(recent)-1].Timestamp,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+}
+
+func newIncidentEvent(m *monitor.Monitor, current *monitor.HealthLog, i *incident.Incident, previous string) *notification.Event {
+	ev := &notification.Event{
+		Type:           notification.EventIncidentOpened,
+		Monitor:        m,
+		PreviousStatus: previous,
+		NewStatus:      monitor.StatusDown,
+		IncidentID:     i.ID.String(),
+		ResponseMs:     current.ResponseMs,
+		Message:        current.Message,
+		OccurredAt:     current.Timestamp,
+	}
+	if i.Status == incident.StatusResolved {
+		ev.Type = notification.EventIncidentResolved
+		ev.NewStatus = current.Status // 느린 상태로 복구되면 degraded
+	}
+	return ev
+}
@@ -0,0 +1,270 @@
+package monitor
+
+import (
+	"context"
+	"keeplo/internal/domain/incident"
+	"keeplo/internal/domain/monitor"
+	"keeplo/internal/domain/notification"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorExecutor_UpdateIncident(t *testing.T) {
+	const (
+		up       = monitor.StatusUp
+		down     = monitor.StatusDown
+		degraded = monitor.StatusDegraded
+	)
+	type event struct {
+		at       int // statuses 에서 알림이 발생한 위치
+		typ      string
+		previous string
+		status   string
+	}
+
+	tests := []struct {
+		name       string
+		failure    int
+		recovery   int
+		statuses   []string
+		wantEvents []event
+		wantActive bool
+	}{
+		{
+			name:       "opens after N downs",
+			failure:    3,
+			statuses:   []string{up, down, down, down},
+			wantEvents: []event{{at: 3, typ: notification.EventIncidentOpened, previous: up, status: down}},
+			wantActive: true,
+		},
+		{
+			name:     "below threshold",
+			failure:  3,
+			statuses: []string{up, down, down, up, down, down},
+		},
+		{
+			name:       "no history before failures",
+			failure:    2,
+			statuses:   []string{down, down},
+			wantEvents: []event{{at: 1, typ: notification.EventIncidentOpened, previous: monitor.StatusUnknown, status: down}},
+			wantActive: true,
+		},
+		{
+			name:       "default thresholds",
+			statuses:   []string{down, down, down, up, up},
+			wantEvents: []event{{at: 2, typ: notification.EventIncidentOpened, previous: monitor.StatusUnknown, status: down}, {at: 4, typ: notification.EventIncidentResolved, previous: down, status: up}},
+		},
+		{
+			name:       "opens once while down continues",
+			failure:    2,
+			statuses:   []string{up, down, down, down, down},
+			wantEvents: []event{{at: 2, typ: notification.EventIncidentOpened, previous: up, status: down}},
+			wantActive: true,
+		},
+		{
+			name:     "degraded never opens",
+			failure:  2,
+			statuses: []string{degraded, degraded, degraded, degraded},
+		},
+		{
+			name:       "degraded does not reset failures",
+			failure:    3,
+			statuses:   []string{up, down, degraded, down, degraded, down},
+			wantEvents: []event{{at: 5, typ: notification.EventIncidentOpened, previous: up, status: down}},
+			wantActive: true,
+		},
+		{
+			name:       "previous status degraded",
+			failure:    2,
+			statuses:   []string{degraded, down, down},
+			wantEvents: []event{{at: 2, typ: notification.EventIncidentOpened, previous: degraded, status: down}},
+			wantActive: true,
+		},
+		{
+			name:     "resolves after M ups",
+			failure:  2,
+			recovery: 3,
+			statuses: []string{down, down, up, up, up},
+			wantEvents: []event{
+				{at: 1, typ: notification.EventIncidentOpened, previous: monitor.StatusUnknown, status: down},
+				{at: 4, typ: notification.EventIncidentResolved, previous: down, status: up},
+			},
+		},
+		{
+			name:     "down resets recovery",
+			failure:  2,
+			recovery: 2,
+			statuses: []string{down, down, up, down, up},
+			wantEvents: []event{
+				{at: 1, typ: notification.EventIncidentOpened, previous: monitor.StatusUnknown, status: down},
+			},
+			wantActive: true,
+		},
+		{
+			name:     "degraded counts toward recovery",
+			failure:  2,
+			recovery: 2,
+			statuses: []string{down, down, up, degraded},
+			wantEvents: []event{
+				{at: 1, typ: notification.EventIncidentOpened, previous: monitor.StatusUnknown, status: down},
+				{at: 3, typ: notification.EventIncidentResolved, previous: down, status: degraded},
+			},
+		},
+		{
+			name:     "reopens after resolve",
+			failure:  1,
+			recovery: 1,
+			statuses: []string{down, up, down},
+			wantEvents: []event{
+				{at: 0, typ: notification.EventIncidentOpened, previous: monitor.StatusUnknown, status: down},
+				{at: 1, typ: notification.EventIncidentResolved, previous: down, status: up},
+				{at: 2, typ: notification.EventIncidentOpened, previous: up, status: down},
+			},
+			wantActive: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, _, incidents, publisher := newTestExecutor(t)
+			m := &monitor.Monitor{ID: uuid.New(), FailureThreshold: tt.failure, RecoveryThreshold: tt.recovery}
+
+			start := time.Now()
+			var got []event
+			for n, status := range tt.statuses {
+				l := &monitor.HealthLog{MonitorID: m.ID.String(), Status: status, Timestamp: start.Add(time.Duration(n) * time.Minute)}
+				_, err := e.record(context.Background(), m, l)
+				require.NoError(t, err)
+
+				for _, ev := range publisher.events[len(got):] {
+					got = append(got, event{at: n, typ: ev.Type, previous: ev.PreviousStatus, status: ev.NewStatus})
+				}
+			}
+			assert.Equal(t, tt.wantEvents, got)
+
+			_, err := incidents.FindActiveByMonitorID(context.Background(), m.ID.String())
+			assert.Equal(t, tt.wantActive, err == nil)
+		})
+	}
+}
+
+func TestMonitorExecutor_IncidentHealthLogs(t *testing.T) {
+	e, logs, incidents, _ := newTestExecutor(t)
+	m := &monitor.Monitor{ID: uuid.New(), FailureThreshold: 2}
+
+	start := time.Now()
+	for n, status := range []string{monitor.StatusUp, monitor.StatusDown, monitor.StatusDegraded, monitor.StatusDown} {
+		_, err := e.record(context.Background(), m, &monitor.HealthLog{
+			MonitorID: m.ID.String(),
+			Status:    status,
+			Message:   status,
+			Timestamp: start.Add(time.Duration(n) * time.Minute),
+		})
+		require.NoError(t, err)
+	}
+
+	require.Len(t, incidents.incidents, 1)
+	opened := incidents.incidents[0]
+	assert.Equal(t, incident.StatusOpened, opened.Status)
+	assert.Equal(t, []string{logs.logs[1].ID, logs.logs[3].ID}, opened.HealthLogIDs)
+	assert.Equal(t, logs.logs[1].Timestamp, opened.StartedAt)
+	assert.Equal(t, monitor.StatusDown, opened.Cause)
+}
+
+// 스케줄 실행과 수동 실행이 동시에 같은 장애를 확인해도 인시던트와 알림은 하나만 생김
+func TestMonitorExecutor_ConcurrentIncidentOpen(t *testing.T) {
+	e, logs, incidents, _ := newTestExecutor(t)
+	m := &monitor.Monitor{ID: uuid.New(), FailureThreshold: 2}
+
+	for range 2 {
+		require.NoError(t, logs.Create(context.Background(), &monitor.HealthLog{MonitorID: m.ID.String(), Status: monitor.StatusDown, Timestamp: time.Now()}))
+	}
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		opened int
+	)
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			current := &monitor.HealthLog{MonitorID: m.ID.String(), Status: monitor.StatusDown, Timestamp: time.Now()}
+			changed, _, err := e.updateIncident(context.Background(), m, current)
+			assert.NoError(t, err)
+			if changed != nil {
+				mu.Lock()
+				opened++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, opened)
+	assert.Len(t, incidents.incidents, 1)
+}
+
+func TestIncident_Resolve(t *testing.T) {
+	at := time.Now()
+	i := &incident.Incident{Status: incident.StatusOpened}
+
+	require.NoError(t, i.Resolve(at))
+	assert.Equal(t, incident.StatusResolved, i.Status)
+	assert.Equal(t, &at, i.ResolvedAt)
+	assert.False(t, i.IsActive())
+	assert.ErrorIs(t, i.Resolve(at), incident.ErrIncidentResolved)
+}
+
+func TestMonitorService_AcknowledgeIncident(t *testing.T) {
+	e, _, incidents, _ := newTestExecutor(t)
+
+	owner := uuid.New()
+	m := &monitor.Monitor{ID: uuid.New(), UserID: owner, FailureThreshold: 1, RecoveryThreshold: 1}
+	monitors := &memMonitorRepo{monitors: map[string]*monitor.Monitor{m.ID.String(): m}}
+	svc := &monitorService{monitorRepo: monitors, incidentRepo: incidents, executor: e}
+
+	resolvedAt := time.Now()
+	opened := &incident.Incident{ID: uuid.New(), MonitorID: m.ID, Status: incident.StatusOpened, StartedAt: time.Now()}
+	resolved := &incident.Incident{ID: uuid.New(), MonitorID: m.ID, Status: incident.StatusResolved, ResolvedAt: &resolvedAt}
+	incidents.incidents = []*incident.Incident{opened, resolved}
+
+	tests := []struct {
+		name       string
+		incidentID string
+		userID     string
+		wantErr    error
+	}{
+		{name: "invalid id", incidentID: "not-a-uuid", userID: owner.String(), wantErr: incident.ErrIncidentNotFound},
+		{name: "unknown incident", incidentID: uuid.NewString(), userID: owner.String(), wantErr: incident.ErrIncidentNotFound},
+		{name: "other user", incidentID: opened.ID.String(), userID: uuid.NewString(), wantErr: monitor.ErrPermissionDenied},
+		{name: "resolved", incidentID: resolved.ID.String(), userID: owner.String(), wantErr: incident.ErrIncidentResolved},
+		{name: "owner", incidentID: opened.ID.String(), userID: owner.String()},
+		{name: "already acknowledged", incidentID: opened.ID.String(), userID: owner.String(), wantErr: incident.ErrAlreadyAcknowledged},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := svc.AcknowledgeIncident(context.Background(), tt.incidentID, tt.userID)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, incident.StatusAcknowledged, got.Status)
+			assert.NotNil(t, got.AcknowledgedAt)
+		})
+	}
+
+	// 확인한 인시던트도 정상 응답이 이어지면 해소
+	_, err := e.record(context.Background(), m, &monitor.HealthLog{MonitorID: m.ID.String(), Status: monitor.StatusUp, Timestamp: time.Now()})
+	require.NoError(t, err)
+
+	saved, err := incidents.FindByID(context.Background(), opened.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, incident.StatusResolved, saved.Status)
+	assert.NotNil(t, saved.AcknowledgedAt)
+}
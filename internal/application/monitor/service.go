@@ -5,10 +5,16 @@ import (
 	"errors"
 	"fmt"
 	"keeplo/internal/adapter/rest/dto"
+	"keeplo/internal/domain/incident"
 	"keeplo/internal/domain/monitor"
 	"keeplo/internal/domain/user"
 	"keeplo/internal/scheduler"
+	"keeplo/pkg/checker"
+	"keeplo/pkg/idgen"
 	"keeplo/pkg/logger"
+	"math/rand/v2"
+	"net"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,29 +22,50 @@ import (
 	"gorm.io/gorm"
 )
 
-const monitorTimeout = time.Second * 5
+const (
+	monitorTimeout   = time.Second * 5
+	triggerTimeout   = time.Second * 15
+	bootstrapTimeout = time.Second * 30
+
+	healthQueue = "health"
+)
 
 type Service interface {
 	RegisterMonitor(ctx context.Context, userID string, req dto.RegisterMonitorRequest) error
 	SearchMonitorList(ctx context.Context, userID string) ([]*monitor.Monitor, error)
-	SearchMonitor(ctx context.Context, id string) (*monitor.Monitor, error)
+	SearchMonitor(ctx context.Context, id string, userID string) (*monitor.Monitor, error)
 	ModifyMonitor(ctx context.Context, id string, userID string, req dto.UpdateMonitorRequest) error
 	DeleteMonitor(ctx context.Context, id string, userID string) error
 
 	ToggleMonitor(ctx context.Context, monitorID, userID string) error
-	TriggerMonitor(ctx context.Context, monitorID, userID string) error
-	GetSupportedProtocols() []string
+	TriggerMonitor(ctx context.Context, monitorID, userID string) (*monitor.HealthLog, error)
+	GetSupportedProtocols() []checker.Definition
+	ScheduleEnabledMonitors(ctx context.Context) (int, error)
+
+	SearchHealthLogs(ctx context.Context, monitorID, userID string, req dto.HealthLogQueryRequest) ([]*monitor.HealthLog, string, error)
+	SearchUserHealthLogs(ctx context.Context, userID string, req dto.HealthLogQueryRequest) ([]*monitor.HealthLog, string, error)
+	SearchMonitorStatuses(ctx context.Context, userID string) ([]*monitor.MonitorStatus, error)
+
+	ReceivePing(ctx context.Context, token, kind, message string) error
+
+	AcknowledgeIncident(ctx context.Context, incidentID, userID string) (*incident.Incident, error)
 }
 
 type monitorService struct {
-	monitorRepo monitor.Repository
-	userRepo    user.Repository
+	monitorRepo   monitor.Repository
+	healthLogRepo monitor.HealthLogRepository
+	incidentRepo  incident.Repository
+	userRepo      user.Repository
+	executor      *MonitorExecutor
 }
 
-func NewMonitorService(mRepo monitor.Repository, uRepo user.Repository) Service {
+func NewMonitorService(mRepo monitor.Repository, hRepo monitor.HealthLogRepository, iRepo incident.Repository, uRepo user.Repository, publisher EventPublisher) Service {
 	return &monitorService{
-		monitorRepo: mRepo,
-		userRepo:    uRepo,
+		monitorRepo:   mRepo,
+		healthLogRepo: hRepo,
+		incidentRepo:  iRepo,
+		userRepo:      uRepo,
+		executor:      NewMonitorExecutor(mRepo, hRepo, iRepo, publisher),
 	}
 }
 
@@ -49,23 +76,68 @@ func (m *monitorService) RegisterMonitor(ctx context.Context, userID string, req
 	log := logger.WithContext(ctx)
 	log.Debug("RegisterMonitor - called", zap.String("user_id", userID), zap.String("name", req.Name))
 
-	if req.Type == "" || req.Address == "" || req.Port == "" {
+	proto, ok := checker.Lookup(req.Type)
+	if !ok {
+		log.Warn("RegisterMonitor - unsupported protocol", zap.String("type", req.Type))
+		return fmt.Errorf("%w: %s", monitor.ErrUnsupportedProtocol, req.Type)
+	}
+	req.Type = proto.Name
+
+	heartbeat := req.Type == monitor.TypeHeartbeat
+	if proto.HostOnly {
+		req.Port = ""
+	}
+	if !heartbeat && (req.Address == "" || (req.Port == "" && !proto.HostOnly)) {
 		log.Warn("RegisterMonitor - invalid request data", zap.Any("request", req))
 		return monitor.ErrInvalidMonitorData
 	}
 
-	target := fmt.Sprintf("%s://%s:%s", req.Type, req.Address, req.Port)
+	settings, err := applySettings(nil, req.Settings)
+	if err == nil {
+		err = validateSettings(settings)
+	}
+	if err != nil {
+		log.Warn("RegisterMonitor - invalid settings", zap.Error(err))
+		return err
+	}
+
+	target := buildTarget(req.Type, req.Address, req.Port)
+	var pingToken string
+	if heartbeat {
+		// 하트비트는 접속 대상이 없으므로 주소 대신 종류만 표시
+		target = monitor.TypeHeartbeat
+		pingToken = idgen.GenerateUUID()
+	}
 	id := uuid.New()
 	newMonitor := &monitor.Monitor{
-		ID:              id,
-		UserID:          uuid.MustParse(userID),
-		Name:            req.Name,
-		Target:          target,
-		Type:            req.Type,
-		IntervalSeconds: req.IntervalSeconds,
-		Enabled:         true,
-		CreatedAt:       time.Now(),
-		UpdatedAt:       time.Now(),
+		ID:                id,
+		UserID:            uuid.MustParse(userID),
+		Name:              req.Name,
+		Target:            target,
+		Type:              req.Type,
+		IntervalSeconds:   req.IntervalSeconds,
+		Enabled:           true,
+		FailureThreshold:  req.FailureThreshold,
+		RecoveryThreshold: req.RecoveryThreshold,
+		TimeoutSeconds:    req.TimeoutSeconds,
+		DegradedMs:        req.DegradedMs,
+		Settings:          settings,
+		PingToken:         pingToken,
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+	}
+	// 하트비트는 기한을 한 번 넘기면 바로 장애로, 핑 한 번이면 바로 복구로 판단
+	if newMonitor.FailureThreshold == 0 {
+		newMonitor.FailureThreshold = monitor.DefaultFailureThreshold
+		if heartbeat {
+			newMonitor.FailureThreshold = 1
+		}
+	}
+	if newMonitor.RecoveryThreshold == 0 {
+		newMonitor.RecoveryThreshold = monitor.DefaultRecoveryThreshold
+		if heartbeat {
+			newMonitor.RecoveryThreshold = 1
+		}
 	}
 
 	// 1. DB 저장
@@ -75,13 +147,8 @@ func (m *monitorService) RegisterMonitor(ctx context.Context, userID string, req
 	}
 
 	// 2. 스케줄러 등록
-	task := &scheduler.Task{
-		ID:          newMonitor.ID.String(),
-		Executor:    &MonitorExecutor{},
-		Interval:    time.Duration(newMonitor.IntervalSeconds) * time.Second,
-		NextCheckAt: time.Now().Add(time.Duration(newMonitor.IntervalSeconds) * time.Second),
-	}
-	if err := scheduler.RegisterTask(ctx, "health", task); err != nil {
+	task := m.newMonitorTask(newMonitor, time.Now().Add(taskInterval(newMonitor)))
+	if err := scheduler.RegisterTask(ctx, healthQueue, task); err != nil {
 		log.Error("RegisterMonitor - failed to register scheduler", zap.Error(err))
 		return err
 	}
@@ -107,12 +174,12 @@ func (m *monitorService) SearchMonitorList(ctx context.Context, userID string) (
 	return monitors, nil
 }
 
-func (m *monitorService) SearchMonitor(ctx context.Context, id string) (*monitor.Monitor, error) {
+func (m *monitorService) SearchMonitor(ctx context.Context, id string, userID string) (*monitor.Monitor, error) {
 	ctx, cancel := context.WithTimeout(ctx, monitorTimeout)
 	defer cancel()
 
 	log := logger.WithContext(ctx)
-	log.Debug("SearchMonitor - called", zap.String("monitor_id", id))
+	log.Debug("SearchMonitor - called", zap.String("monitor_id", id), zap.String("user_id", userID))
 
 	result, err := m.monitorRepo.FindByID(ctx, id)
 	if err != nil {
@@ -123,6 +190,10 @@ func (m *monitorService) SearchMonitor(ctx context.Context, id string) (*monitor
 		log.Error("SearchMonitor - failed", zap.Error(err))
 		return nil, err
 	}
+	if result.UserID.String() != userID {
+		log.Warn("SearchMonitor - permission denied", zap.String("monitor_id", id), zap.String("user_id", userID))
+		return nil, monitor.ErrPermissionDenied
+	}
 
 	log.Info("SearchMonitor - success", zap.String("monitor_id", result.ID.String()))
 	return result, nil
@@ -149,17 +220,73 @@ func (m *monitorService) ModifyMonitor(ctx context.Context, id string, userID st
 		return monitor.ErrPermissionDenied
 	}
 
+	wasHeartbeat := existing.IsHeartbeat()
+	typeChanged := false
 	if req.Name != nil {
 		existing.Name = *req.Name
 	}
 	if req.Type != nil {
-		existing.Type = *req.Type
+		proto, ok := checker.Lookup(*req.Type)
+		if !ok {
+			log.Warn("ModifyMonitor - unsupported protocol", zap.String("type", *req.Type))
+			return fmt.Errorf("%w: %s", monitor.ErrUnsupportedProtocol, *req.Type)
+		}
+		typeChanged = proto.Name != existing.Type
+		existing.Type = proto.Name
 	}
 	if req.IntervalSeconds != nil {
 		existing.IntervalSeconds = *req.IntervalSeconds
 	}
-	if req.Address != nil && req.Port != nil && req.Type != nil {
-		existing.Target = fmt.Sprintf("%s://%s:%s", *req.Type, *req.Address, *req.Port)
+	if req.FailureThreshold != nil {
+		existing.FailureThreshold = *req.FailureThreshold
+	}
+	if req.RecoveryThreshold != nil {
+		existing.RecoveryThreshold = *req.RecoveryThreshold
+	}
+	if req.TimeoutSeconds != nil {
+		existing.TimeoutSeconds = *req.TimeoutSeconds
+	}
+	if req.DegradedMs != nil {
+		existing.DegradedMs = *req.DegradedMs
+	}
+	settings, err := applySettings(existing.Settings, req.Settings)
+	if err == nil {
+		err = validateSettings(settings)
+	}
+	if err != nil {
+		log.Warn("ModifyMonitor - invalid settings", zap.Error(err))
+		return err
+	}
+	existing.Settings = settings
+	// 주소, 포트 중 보내지 않은 값은 저장된 대상에서 가져와서 새 종류의 스킴으로 다시 구성
+	if !existing.IsHeartbeat() && (typeChanged || req.Address != nil || req.Port != nil) {
+		proto, _ := checker.Lookup(existing.Type)
+		host, port := existing.HostPort()
+		if req.Address != nil {
+			host = *req.Address
+		}
+		if req.Port != nil {
+			port = *req.Port
+		}
+		if proto.HostOnly {
+			port = ""
+		}
+		if host == "" || (port == "" && !proto.HostOnly) {
+			log.Warn("ModifyMonitor - missing address or port", zap.String("monitor_id", id), zap.String("type", existing.Type))
+			return monitor.ErrInvalidMonitorData
+		}
+		existing.Target = buildTarget(existing.Type, host, port)
+	}
+	if existing.IsHeartbeat() {
+		existing.Target = monitor.TypeHeartbeat
+		if existing.PingToken == "" {
+			existing.PingToken = idgen.GenerateUUID()
+		}
+	} else if wasHeartbeat {
+		// 하트비트가 아니게 되면 기존 핑 URL 로 더 이상 기록되지 않도록 토큰과 핑 상태를 비움
+		existing.PingToken = ""
+		existing.LastPingAt = nil
+		existing.RunStartedAt = nil
 	}
 	existing.UpdatedAt = time.Now()
 
@@ -167,6 +294,17 @@ func (m *monitorService) ModifyMonitor(ctx context.Context, id string, userID st
 		log.Error("ModifyMonitor - update failed", zap.Error(err))
 		return err
 	}
+	if wasHeartbeat && !existing.IsHeartbeat() {
+		if err := m.monitorRepo.UpdatePingState(ctx, existing.ID.String(), nil, nil); err != nil {
+			log.Error("ModifyMonitor - failed to clear ping state", zap.Error(err))
+			return err
+		}
+	}
+
+	if err := m.syncSchedule(ctx, existing); err != nil {
+		log.Error("ModifyMonitor - failed to sync scheduler", zap.Error(err))
+		return err
+	}
 
 	log.Info("ModifyMonitor - success", zap.String("monitor_id", existing.ID.String()))
 	return nil
@@ -197,6 +335,7 @@ func (m *monitorService) DeleteMonitor(ctx context.Context, id string, userID st
 		log.Error("DeleteMonitor - soft delete failed", zap.Error(err))
 		return err
 	}
+	scheduler.RemoveTask(healthQueue, id)
 
 	log.Info("DeleteMonitor - success", zap.String("monitor_id", id))
 	return nil
@@ -226,37 +365,114 @@ func (m *monitorService) ToggleMonitor(ctx context.Context, monitorID, userID st
 		return err
 	}
 
+	if err := m.syncSchedule(ctx, monitorObj); err != nil {
+		log.Error("ToggleMonitor - failed to sync scheduler", zap.String("monitor_id", monitorID), zap.Error(err))
+		return err
+	}
+
 	log.Info("ToggleMonitor - status toggled", zap.String("monitor_id", monitorID), zap.Bool("is_active", monitorObj.Enabled))
 	return nil
 }
 
-func (m *monitorService) TriggerMonitor(ctx context.Context, monitorID, userID string) error {
-	ctx, cancel := context.WithTimeout(ctx, monitorTimeout)
+// 스케줄과 별개로 즉시 체크를 수행하고 결과를 저장해서 반환
+// 기한 안의 하트비트처럼 새로 남길 결과가 없으면 nil
+func (m *monitorService) TriggerMonitor(ctx context.Context, monitorID, userID string) (*monitor.HealthLog, error) {
+	ctx, cancel := context.WithTimeout(ctx, triggerTimeout)
 	defer cancel()
 
 	log := logger.WithContext(ctx)
 	monitorObj, err := m.monitorRepo.FindByID(ctx, monitorID)
 	if err != nil {
 		log.Error("TriggerMonitor - monitor not found", zap.String("monitor_id", monitorID), zap.Error(err))
-		return monitor.ErrMonitorNotFound
+		return nil, monitor.ErrMonitorNotFound
 	}
 
 	if monitorObj.UserID.String() != userID {
 		log.Warn("TriggerMonitor - no permission", zap.String("user_id", userID))
-		return monitor.ErrPermissionDenied
+		return nil, monitor.ErrPermissionDenied
 	}
 
-	// 실제 모니터링 테스트 수행 - 간단 예시 (Ping / HTTP 등)
-	// result, err := s.pingNow(ctx, monitor)
-	// if err != nil {
-	// 	log.Error("TriggerMonitor - monitor test failed", zap.String("monitor_id", monitorID), zap.Error(err))
-	// 	return err
-	// }
+	result, err := m.executor.Run(ctx, monitorObj)
+	if err != nil {
+		log.Error("TriggerMonitor - monitor test failed", zap.String("monitor_id", monitorID), zap.Error(err))
+		return nil, err
+	}
+	if result == nil {
+		log.Info("TriggerMonitor - no new result", zap.String("monitor_id", monitorID))
+		return nil, nil
+	}
 
-	log.Info("TriggerMonitor - test executed", zap.String("monitor_id", monitorID), zap.String("status", "test"))
-	return nil
+	log.Info("TriggerMonitor - test executed", zap.String("monitor_id", monitorID), zap.String("status", result.Status), zap.Int("ms", result.ResponseMs))
+	return result, nil
+}
+
+// checker 레지스트리에 등록된 프로토콜 목록
+func (s *monitorService) GetSupportedProtocols() []checker.Definition {
+	return checker.Definitions()
+}
+
+// 서버 기동 시 활성 모니터를 스케줄러에 다시 등록
+// 동시에 몰리지 않도록 각 모니터의 첫 체크 시각을 주기 안에서 무작위로 분산
+func (m *monitorService) ScheduleEnabledMonitors(ctx context.Context) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, bootstrapTimeout)
+	defer cancel()
+
+	log := logger.WithContext(ctx)
+	monitors, err := m.monitorRepo.FindAllEnabled(ctx)
+	if err != nil {
+		log.Error("ScheduleEnabledMonitors - fetch failed", zap.Error(err))
+		return 0, err
+	}
+
+	now := time.Now()
+	registered := 0
+	for _, mo := range monitors {
+		interval := time.Duration(mo.IntervalSeconds) * time.Second
+		if interval <= 0 {
+			log.Warn("ScheduleEnabledMonitors - invalid interval", zap.String("monitor_id", mo.ID.String()), zap.Int("interval", mo.IntervalSeconds))
+			continue
+		}
+
+		task := m.newMonitorTask(mo, now.Add(rand.N(interval)))
+		if err := scheduler.RegisterTask(ctx, healthQueue, task); err != nil {
+			log.Error("ScheduleEnabledMonitors - register failed", zap.String("monitor_id", mo.ID.String()), zap.Error(err))
+			continue
+		}
+		registered++
+	}
+
+	log.Info("ScheduleEnabledMonitors - success", zap.Int("total", len(monitors)), zap.Int("registered", registered))
+	return registered, nil
 }
 
-func (s *monitorService) GetSupportedProtocols() []string {
-	return []string{"HTTP", "HTTPS", "TCP", "WebSocket"}
+// 모니터 변경 사항을 스케줄러에 반영. 기존 Task 를 제거한 뒤 활성 상태일 때만 새 주기로 다시 등록
+func (m *monitorService) syncSchedule(ctx context.Context, mo *monitor.Monitor) error {
+	scheduler.RemoveTask(healthQueue, mo.ID.String())
+	if !mo.Enabled {
+		return nil
+	}
+
+	return scheduler.RegisterTask(ctx, healthQueue, m.newMonitorTask(mo, time.Now().Add(taskInterval(mo))))
+}
+
+// 체크 대상 "type://host:port"
+// 포트가 없으면 "type://host", IPv6 주소는 대괄호로 감쌈
+func buildTarget(typ, host, port string) string {
+	if port == "" {
+		if strings.Contains(host, ":") {
+			host = "[" + host + "]"
+		}
+		return typ + "://" + host
+	}
+	return typ + "://" + net.JoinHostPort(host, port)
+}
+
+func (m *monitorService) newMonitorTask(mo *monitor.Monitor, nextCheckAt time.Time) *scheduler.Task {
+	return &scheduler.Task{
+		ID:          mo.ID.String(),
+		Executor:    m.executor,
+		Payload:     mo,
+		Interval:    taskInterval(mo),
+		NextCheckAt: nextCheckAt,
+	}
 }
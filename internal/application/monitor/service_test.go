@@ -0,0 +1,248 @@
+package monitor
+
+import (
+	"context"
+	"keeplo/internal/adapter/rest/dto"
+	"keeplo/internal/domain/monitor"
+	"keeplo/internal/scheduler"
+	"keeplo/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestMonitorService_ModifyMonitorTarget(t *testing.T) {
+	logger.Log = zap.NewNop()
+	scheduler.NewScheduler()
+
+	userID := uuid.New()
+	lastPing := time.Now().Add(-time.Minute)
+	newHTTP := func() *monitor.Monitor {
+		return &monitor.Monitor{ID: uuid.New(), UserID: userID, Type: "http", Target: "http://example.com:80", IntervalSeconds: 60}
+	}
+	newDNS := func() *monitor.Monitor {
+		return &monitor.Monitor{ID: uuid.New(), UserID: userID, Type: "dns", Target: "dns://example.com", IntervalSeconds: 60}
+	}
+	newHeartbeat := func() *monitor.Monitor {
+		return &monitor.Monitor{
+			ID:              uuid.New(),
+			UserID:          userID,
+			Type:            monitor.TypeHeartbeat,
+			Target:          monitor.TypeHeartbeat,
+			IntervalSeconds: 60,
+			PingToken:       "ping-token",
+			LastPingAt:      &lastPing,
+			RunStartedAt:    &lastPing,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		existing      *monitor.Monitor
+		req           dto.UpdateMonitorRequest
+		wantErr       error
+		wantTarget    string
+		wantType      string
+		wantPingToken bool
+	}{
+		{
+			name:       "type only keeps host and port",
+			existing:   newHTTP(),
+			req:        dto.UpdateMonitorRequest{Type: ptr("tcp")},
+			wantTarget: "tcp://example.com:80",
+			wantType:   "tcp",
+		},
+		{
+			name:       "alias resolves to canonical scheme",
+			existing:   newHTTP(),
+			req:        dto.UpdateMonitorRequest{Type: ptr("ping")},
+			wantTarget: "icmp://example.com",
+			wantType:   "icmp",
+		},
+		{
+			name:       "host only type drops port",
+			existing:   newHTTP(),
+			req:        dto.UpdateMonitorRequest{Type: ptr("dns"), Port: ptr("53")},
+			wantTarget: "dns://example.com",
+			wantType:   "dns",
+		},
+		{
+			name:       "host only address change",
+			existing:   newDNS(),
+			req:        dto.UpdateMonitorRequest{Address: ptr("::1")},
+			wantTarget: "dns://[::1]",
+			wantType:   "dns",
+		},
+		{
+			name:       "from host only with port",
+			existing:   newDNS(),
+			req:        dto.UpdateMonitorRequest{Type: ptr("tcp"), Port: ptr("53")},
+			wantTarget: "tcp://example.com:53",
+			wantType:   "tcp",
+		},
+		{
+			name:     "from host only without port",
+			existing: newDNS(),
+			req:      dto.UpdateMonitorRequest{Type: ptr("tcp")},
+			wantErr:  monitor.ErrInvalidMonitorData,
+		},
+		{
+			name:       "from heartbeat to host only without port",
+			existing:   newHeartbeat(),
+			req:        dto.UpdateMonitorRequest{Type: ptr("icmp"), Address: ptr("example.com")},
+			wantTarget: "icmp://example.com",
+			wantType:   "icmp",
+		},
+		{
+			name:       "port only",
+			existing:   newHTTP(),
+			req:        dto.UpdateMonitorRequest{Port: ptr("8080")},
+			wantTarget: "http://example.com:8080",
+			wantType:   "http",
+		},
+		{
+			name:       "address only",
+			existing:   newHTTP(),
+			req:        dto.UpdateMonitorRequest{Address: ptr("api.example.com")},
+			wantTarget: "http://api.example.com:80",
+			wantType:   "http",
+		},
+		{
+			name:       "name only keeps target",
+			existing:   newHTTP(),
+			req:        dto.UpdateMonitorRequest{Name: ptr("api")},
+			wantTarget: "http://example.com:80",
+			wantType:   "http",
+		},
+		{
+			name:          "to heartbeat",
+			existing:      newHTTP(),
+			req:           dto.UpdateMonitorRequest{Type: ptr(monitor.TypeHeartbeat)},
+			wantTarget:    monitor.TypeHeartbeat,
+			wantType:      monitor.TypeHeartbeat,
+			wantPingToken: true,
+		},
+		{
+			name:       "from heartbeat with address and port",
+			existing:   newHeartbeat(),
+			req:        dto.UpdateMonitorRequest{Type: ptr("http"), Address: ptr("example.com"), Port: ptr("443")},
+			wantTarget: "http://example.com:443",
+			wantType:   "http",
+		},
+		{
+			name:     "from heartbeat without address",
+			existing: newHeartbeat(),
+			req:      dto.UpdateMonitorRequest{Type: ptr("tcp"), Port: ptr("22")},
+			wantErr:  monitor.ErrInvalidMonitorData,
+		},
+		{
+			name:     "from heartbeat with type only",
+			existing: newHeartbeat(),
+			req:      dto.UpdateMonitorRequest{Type: ptr("http")},
+			wantErr:  monitor.ErrInvalidMonitorData,
+		},
+		{
+			name:     "empty port",
+			existing: newHTTP(),
+			req:      dto.UpdateMonitorRequest{Port: ptr("")},
+			wantErr:  monitor.ErrInvalidMonitorData,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := tt.existing.ID.String()
+			repo := &memMonitorRepo{monitors: map[string]*monitor.Monitor{id: tt.existing}}
+			svc := NewMonitorService(repo, nil, nil, nil, nil)
+
+			err := svc.ModifyMonitor(context.Background(), id, userID.String(), tt.req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			saved := repo.monitors[id]
+			assert.Equal(t, tt.wantTarget, saved.Target)
+			assert.Equal(t, tt.wantType, saved.Type)
+			if tt.wantPingToken {
+				assert.NotEmpty(t, saved.PingToken)
+				return
+			}
+			assert.Empty(t, saved.PingToken)
+			assert.Nil(t, saved.LastPingAt)
+			assert.Nil(t, saved.RunStartedAt)
+		})
+	}
+}
+
+func TestMonitorService_ModifyMonitorPermission(t *testing.T) {
+	logger.Log = zap.NewNop()
+
+	m := &monitor.Monitor{ID: uuid.New(), UserID: uuid.New(), Type: "http", Target: "http://example.com:80"}
+	repo := &memMonitorRepo{monitors: map[string]*monitor.Monitor{m.ID.String(): m}}
+	svc := NewMonitorService(repo, nil, nil, nil, nil)
+
+	err := svc.ModifyMonitor(context.Background(), m.ID.String(), uuid.NewString(), dto.UpdateMonitorRequest{Name: ptr("taken")})
+	assert.ErrorIs(t, err, monitor.ErrPermissionDenied)
+	assert.Empty(t, repo.monitors[m.ID.String()].Name)
+}
+
+func TestMonitorService_RegisterMonitorPort(t *testing.T) {
+	logger.Log = zap.NewNop()
+	scheduler.NewScheduler()
+	scheduler.AddQueue(healthQueue, scheduler.NewInMemoryQueue())
+
+	tests := []struct {
+		name       string
+		req        dto.RegisterMonitorRequest
+		wantErr    error
+		wantTarget string
+	}{
+		{
+			name:       "dns without port",
+			req:        dto.RegisterMonitorRequest{Name: "dns", Type: "dns", Address: "example.com", IntervalSeconds: 60},
+			wantTarget: "dns://example.com",
+		},
+		{
+			name:       "ping alias ignores port",
+			req:        dto.RegisterMonitorRequest{Name: "ping", Type: "ping", Address: "example.com", Port: "7", IntervalSeconds: 60},
+			wantTarget: "icmp://example.com",
+		},
+		{
+			name:       "tcp with port",
+			req:        dto.RegisterMonitorRequest{Name: "tcp", Type: "tcp", Address: "example.com", Port: "22", IntervalSeconds: 60},
+			wantTarget: "tcp://example.com:22",
+		},
+		{
+			name:    "tcp without port",
+			req:     dto.RegisterMonitorRequest{Name: "tcp", Type: "tcp", Address: "example.com", IntervalSeconds: 60},
+			wantErr: monitor.ErrInvalidMonitorData,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &memMonitorRepo{monitors: map[string]*monitor.Monitor{}}
+			svc := NewMonitorService(repo, nil, nil, nil, nil)
+
+			err := svc.RegisterMonitor(context.Background(), uuid.NewString(), tt.req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, repo.monitors)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, repo.monitors, 1)
+			for _, m := range repo.monitors {
+				assert.Equal(t, tt.wantTarget, m.Target)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package monitor
+
+import (
+	"fmt"
+	"keeplo/internal/domain/monitor"
+	"keeplo/pkg/checker"
+)
+
+// 요청에 포함된 설정 블록만 통째로 교체한 사본. null 블록은 삭제
+// 가려진 인증 정보(checker.SecretMask)는 기존 값을 유지
+func applySettings(dst monitor.Settings, req monitor.Settings) (monitor.Settings, error) {
+	out := dst
+	for key, raw := range req {
+		def, ok := checker.LookupSettings(key)
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown settings %q", monitor.ErrInvalidMonitorData, key)
+		}
+		if string(raw) == "null" {
+			out = out.With(key, nil)
+			continue
+		}
+
+		block, err := def.KeepSecrets(raw, dst.Block(key))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", monitor.ErrInvalidMonitorData, key, err)
+		}
+		out = out.With(key, block)
+	}
+	return out, nil
+}
+
+// 설정 블록마다 해당 블록을 선언한 checker 정의로 검증
+func validateSettings(s monitor.Settings) error {
+	for key, raw := range s {
+		def, ok := checker.LookupSettings(key)
+		if !ok {
+			return fmt.Errorf("%w: unknown settings %q", monitor.ErrInvalidMonitorData, key)
+		}
+		if def.Validate == nil {
+			continue
+		}
+		if err := def.Validate(raw); err != nil {
+			return fmt.Errorf("%w: %s: %v", monitor.ErrInvalidMonitorData, key, err)
+		}
+	}
+	return nil
+}
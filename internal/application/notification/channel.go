@@ -0,0 +1,312 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keeplo/internal/adapter/rest/dto"
+	"keeplo/internal/domain/monitor"
+	"keeplo/internal/domain/notification"
+	"keeplo/pkg/idgen"
+	"keeplo/pkg/logger"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	channelTestTimeout = time.Second * 20
+	webhookSecretBytes = 32
+
+	// 테스트 전송은 사용자당 10분에 5회까지
+	channelTestLimit  = 5
+	channelTestWindow = time.Minute * 10
+)
+
+func (s *notificationService) RegisterChannel(ctx context.Context, userID string, req dto.RegisterChannelRequest) (*notification.Channel, error) {
+	ctx, cancel := context.WithTimeout(ctx, notificationTimeout)
+	defer cancel()
+
+	log := logger.WithContext(ctx)
+	log.Debug("RegisterChannel - called", zap.String("user_id", userID), zap.String("type", req.Type))
+
+	now := time.Now()
+	ch := &notification.Channel{
+		ID:        uuid.New(),
+		UserID:    uuid.MustParse(userID),
+		Name:      req.Name,
+		Type:      req.Type,
+		Target:    req.Target,
+		Secret:    req.Secret,
+		Enabled:   true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	// 서명 키는 채널마다 따로 발급해서 다른 채널의 서명을 만들 수 없게 함
+	if ch.Type == notification.ChannelWebhook && ch.Secret == "" {
+		ch.Secret = idgen.GenerateSecret(webhookSecretBytes)
+	}
+	if err := validateChannel(ch); err != nil {
+		log.Warn("RegisterChannel - invalid channel", zap.String("type", req.Type), zap.Error(err))
+		return nil, err
+	}
+	if err := s.checkEmailTarget(ctx, ch); err != nil {
+		log.Warn("RegisterChannel - email target rejected", zap.String("user_id", userID), zap.Error(err))
+		return nil, err
+	}
+
+	if err := s.channelRepo.Create(ctx, ch); err != nil {
+		log.Error("RegisterChannel - failed to create", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("RegisterChannel - success", zap.String("channel_id", ch.ID.String()), zap.String("user_id", userID))
+	return ch, nil
+}
+
+func (s *notificationService) SearchChannelList(ctx context.Context, userID string) ([]*notification.Channel, error) {
+	ctx, cancel := context.WithTimeout(ctx, notificationTimeout)
+	defer cancel()
+
+	log := logger.WithContext(ctx)
+	channels, err := s.channelRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		log.Error("SearchChannelList - failed", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("SearchChannelList - success", zap.Int("count", len(channels)))
+	return channels, nil
+}
+
+func (s *notificationService) SearchChannel(ctx context.Context, id, userID string) (*notification.Channel, []string, error) {
+	ctx, cancel := context.WithTimeout(ctx, notificationTimeout)
+	defer cancel()
+
+	log := logger.WithContext(ctx)
+	ch, err := s.findOwnedChannel(ctx, id, userID)
+	if err != nil {
+		log.Warn("SearchChannel - failed", zap.String("channel_id", id), zap.Error(err))
+		return nil, nil, err
+	}
+
+	monitorIDs, err := s.channelRepo.FindMonitorIDs(ctx, id)
+	if err != nil {
+		log.Error("SearchChannel - fetch monitors failed", zap.String("channel_id", id), zap.Error(err))
+		return nil, nil, err
+	}
+
+	log.Info("SearchChannel - success", zap.String("channel_id", id))
+	return ch, monitorIDs, nil
+}
+
+func (s *notificationService) ModifyChannel(ctx context.Context, id, userID string, req dto.UpdateChannelRequest) error {
+	ctx, cancel := context.WithTimeout(ctx, notificationTimeout)
+	defer cancel()
+
+	log := logger.WithContext(ctx)
+	log.Debug("ModifyChannel - called", zap.String("channel_id", id), zap.String("user_id", userID))
+
+	ch, err := s.findOwnedChannel(ctx, id, userID)
+	if err != nil {
+		log.Warn("ModifyChannel - failed", zap.String("channel_id", id), zap.Error(err))
+		return err
+	}
+
+	if req.Name != nil {
+		ch.Name = *req.Name
+	}
+	if req.Target != nil {
+		ch.Target = *req.Target
+	}
+	if req.Secret != nil {
+		ch.Secret = *req.Secret
+	}
+	if req.Enabled != nil {
+		ch.Enabled = *req.Enabled
+	}
+	ch.UpdatedAt = time.Now()
+
+	if err := validateChannel(ch); err != nil {
+		log.Warn("ModifyChannel - invalid channel", zap.String("channel_id", id), zap.Error(err))
+		return err
+	}
+	if err := s.checkEmailTarget(ctx, ch); err != nil {
+		log.Warn("ModifyChannel - email target rejected", zap.String("user_id", userID), zap.Error(err))
+		return err
+	}
+
+	if err := s.channelRepo.Update(ctx, ch); err != nil {
+		log.Error("ModifyChannel - update failed", zap.Error(err))
+		return err
+	}
+
+	log.Info("ModifyChannel - success", zap.String("channel_id", id))
+	return nil
+}
+
+func (s *notificationService) DeleteChannel(ctx context.Context, id, userID string) error {
+	ctx, cancel := context.WithTimeout(ctx, notificationTimeout)
+	defer cancel()
+
+	log := logger.WithContext(ctx)
+	log.Debug("DeleteChannel - called", zap.String("channel_id", id), zap.String("user_id", userID))
+
+	if _, err := s.findOwnedChannel(ctx, id, userID); err != nil {
+		log.Warn("DeleteChannel - failed", zap.String("channel_id", id), zap.Error(err))
+		return err
+	}
+
+	if err := s.channelRepo.Delete(ctx, id); err != nil {
+		log.Error("DeleteChannel - delete failed", zap.Error(err))
+		return err
+	}
+
+	log.Info("DeleteChannel - success", zap.String("channel_id", id))
+	return nil
+}
+
+// 실제 채널로 테스트 메시지를 보내서 설정을 확인
+func (s *notificationService) TestChannel(ctx context.Context, id, userID string) error {
+	ctx, cancel := context.WithTimeout(ctx, channelTestTimeout)
+	defer cancel()
+
+	log := logger.WithContext(ctx)
+	ch, err := s.findOwnedChannel(ctx, id, userID)
+	if err != nil {
+		log.Warn("TestChannel - failed", zap.String("channel_id", id), zap.Error(err))
+		return err
+	}
+
+	if !s.testLimiter.Allow(userID, time.Now()) {
+		log.Warn("TestChannel - rate limited", zap.String("channel_id", id), zap.String("user_id", userID))
+		return notification.ErrTestRateLimited
+	}
+
+	if err := s.dispatcher.SendTest(ctx, ch); err != nil {
+		log.Warn("TestChannel - send failed", zap.String("channel_id", id), zap.Error(err))
+		return fmt.Errorf("%w: %v", notification.ErrChannelTestFailed, err)
+	}
+
+	log.Info("TestChannel - success", zap.String("channel_id", id))
+	return nil
+}
+
+func (s *notificationService) AttachMonitor(ctx context.Context, channelID, monitorID, userID string) error {
+	ctx, cancel := context.WithTimeout(ctx, notificationTimeout)
+	defer cancel()
+
+	log := logger.WithContext(ctx)
+	if err := s.checkLinkOwnership(ctx, channelID, monitorID, userID); err != nil {
+		log.Warn("AttachMonitor - failed", zap.String("channel_id", channelID), zap.String("monitor_id", monitorID), zap.Error(err))
+		return err
+	}
+
+	if err := s.channelRepo.AttachMonitor(ctx, channelID, monitorID); err != nil {
+		log.Error("AttachMonitor - attach failed", zap.Error(err))
+		return err
+	}
+
+	log.Info("AttachMonitor - success", zap.String("channel_id", channelID), zap.String("monitor_id", monitorID))
+	return nil
+}
+
+func (s *notificationService) DetachMonitor(ctx context.Context, channelID, monitorID, userID string) error {
+	ctx, cancel := context.WithTimeout(ctx, notificationTimeout)
+	defer cancel()
+
+	log := logger.WithContext(ctx)
+	if err := s.checkLinkOwnership(ctx, channelID, monitorID, userID); err != nil {
+		log.Warn("DetachMonitor - failed", zap.String("channel_id", channelID), zap.String("monitor_id", monitorID), zap.Error(err))
+		return err
+	}
+
+	if err := s.channelRepo.DetachMonitor(ctx, channelID, monitorID); err != nil {
+		log.Error("DetachMonitor - detach failed", zap.Error(err))
+		return err
+	}
+
+	log.Info("DetachMonitor - success", zap.String("channel_id", channelID), zap.String("monitor_id", monitorID))
+	return nil
+}
+
+func (s *notificationService) findOwnedChannel(ctx context.Context, id, userID string) (*notification.Channel, error) {
+	ch, err := s.channelRepo.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, notification.ErrChannelNotFound
+		}
+		return nil, err
+	}
+	if ch.UserID.String() != userID {
+		return nil, notification.ErrPermissionDenied
+	}
+	return ch, nil
+}
+
+// 채널과 모니터 모두 요청한 사용자의 소유인지 확인
+func (s *notificationService) checkLinkOwnership(ctx context.Context, channelID, monitorID, userID string) error {
+	if _, err := s.findOwnedChannel(ctx, channelID, userID); err != nil {
+		return err
+	}
+
+	m, err := s.monitorRepo.FindByID(ctx, monitorID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return monitor.ErrMonitorNotFound
+		}
+		return err
+	}
+	if m.UserID.String() != userID {
+		return monitor.ErrPermissionDenied
+	}
+	return nil
+}
+
+// 서버 SMTP 로 임의 주소에 메일을 보낼 수 없도록 email 채널 수신 주소는 계정 이메일만 허용
+func (s *notificationService) checkEmailTarget(ctx context.Context, ch *notification.Channel) error {
+	if ch.Type != notification.ChannelEmail || ch.Target == "" {
+		return nil
+	}
+
+	u, err := s.userRepo.FindByID(ctx, ch.UserID.String())
+	if err != nil {
+		return err
+	}
+	to, err := mail.ParseAddress(ch.Target)
+	if err != nil || !strings.EqualFold(to.Address, u.Email) {
+		return fmt.Errorf("%w: email target must be the account email", notification.ErrInvalidChannelData)
+	}
+	return nil
+}
+
+func validateChannel(ch *notification.Channel) error {
+	switch ch.Type {
+	case notification.ChannelWebhook, notification.ChannelSlack, notification.ChannelDiscord:
+		u, err := url.Parse(ch.Target)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%w: target must be an http(s) URL", notification.ErrInvalidChannelData)
+		}
+		if ch.Type == notification.ChannelWebhook && ch.Secret == "" {
+			return fmt.Errorf("%w: webhook requires a signing secret", notification.ErrInvalidChannelData)
+		}
+	case notification.ChannelEmail:
+		if ch.Target != "" {
+			if _, err := mail.ParseAddress(ch.Target); err != nil {
+				return fmt.Errorf("%w: invalid email address", notification.ErrInvalidChannelData)
+			}
+		}
+	case notification.ChannelTelegram:
+		if ch.Target == "" || ch.Secret == "" {
+			return fmt.Errorf("%w: telegram requires chat id and bot token", notification.ErrInvalidChannelData)
+		}
+	default:
+		return fmt.Errorf("%w: %s", notification.ErrUnsupportedChannel, ch.Type)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"keeplo/internal/adapter/rest/dto"
+	"keeplo/internal/domain/notification"
+	"keeplo/internal/domain/user"
+	"keeplo/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type memChannelRepo struct {
+	notification.ChannelRepository
+	channels map[string]*notification.Channel
+}
+
+func (r *memChannelRepo) Create(ctx context.Context, ch *notification.Channel) error {
+	r.channels[ch.ID.String()] = ch
+	return nil
+}
+
+func (r *memChannelRepo) Update(ctx context.Context, ch *notification.Channel) error {
+	r.channels[ch.ID.String()] = ch
+	return nil
+}
+
+func (r *memChannelRepo) FindByID(ctx context.Context, id string) (*notification.Channel, error) {
+	ch, ok := r.channels[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	c := *ch
+	return &c, nil
+}
+
+type memUserRepo struct {
+	user.Repository
+	users map[string]*user.User
+}
+
+func (r *memUserRepo) FindByID(ctx context.Context, id string) (*user.User, error) {
+	u, ok := r.users[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return u, nil
+}
+
+type countingNotifier struct {
+	sent int
+}
+
+func (n *countingNotifier) Send(ctx context.Context, ch *notification.Channel, ev *notification.Event) error {
+	n.sent++
+	return nil
+}
+
+func newTestService(t *testing.T) (*notificationService, *user.User, *countingNotifier) {
+	t.Helper()
+	logger.Log = zap.NewNop()
+
+	owner := &user.User{ID: uuid.New(), Email: "owner@example.com"}
+	channels := &memChannelRepo{channels: map[string]*notification.Channel{}}
+	n := &countingNotifier{}
+	dispatcher := NewDispatcher(channels, nil, map[string]notification.Notifier{notification.ChannelEmail: n})
+	s := NewNotificationService(nil, channels, nil, &memUserRepo{users: map[string]*user.User{owner.ID.String(): owner}}, dispatcher)
+	return s.(*notificationService), owner, n
+}
+
+func TestNotificationService_EmailTarget(t *testing.T) {
+	s, owner, _ := newTestService(t)
+	userID := owner.ID.String()
+
+	tests := []struct {
+		name    string
+		target  string
+		wantErr bool
+	}{
+		{"empty uses account email", "", false},
+		{"account email", "owner@example.com", false},
+		{"account email with name and case", "Ops <OWNER@example.com>", false},
+		{"other address", "victim@example.com", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.RegisterChannel(context.Background(), userID, dto.RegisterChannelRequest{Name: "mail", Type: notification.ChannelEmail, Target: tt.target})
+			if tt.wantErr {
+				assert.ErrorIs(t, err, notification.ErrInvalidChannelData)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	ch, err := s.RegisterChannel(context.Background(), userID, dto.RegisterChannelRequest{Name: "mail", Type: notification.ChannelEmail})
+	require.NoError(t, err)
+
+	other := "victim@example.com"
+	err = s.ModifyChannel(context.Background(), ch.ID.String(), userID, dto.UpdateChannelRequest{Target: &other})
+	assert.ErrorIs(t, err, notification.ErrInvalidChannelData)
+}
+
+func TestNotificationService_TestChannelRateLimit(t *testing.T) {
+	s, owner, n := newTestService(t)
+	userID := owner.ID.String()
+
+	ch, err := s.RegisterChannel(context.Background(), userID, dto.RegisterChannelRequest{Name: "mail", Type: notification.ChannelEmail})
+	require.NoError(t, err)
+
+	for i := 0; i < channelTestLimit; i++ {
+		require.NoError(t, s.TestChannel(context.Background(), ch.ID.String(), userID))
+	}
+	err = s.TestChannel(context.Background(), ch.ID.String(), userID)
+	assert.True(t, errors.Is(err, notification.ErrTestRateLimited))
+	assert.Equal(t, channelTestLimit, n.sent)
+}
+
+func TestTestLimiter(t *testing.T) {
+	l := newTestLimiter(2, time.Minute)
+	now := time.Now()
+
+	assert.True(t, l.Allow("a", now))
+	assert.True(t, l.Allow("a", now.Add(30*time.Second)))
+	assert.False(t, l.Allow("a", now.Add(31*time.Second)))
+	assert.True(t, l.Allow("b", now.Add(31*time.Second)), "limits are per key")
+
+	assert.True(t, l.Allow("a", now.Add(time.Minute+time.Second)), "oldest call expired")
+	assert.False(t, l.Allow("a", now.Add(time.Minute+time.Second)))
+}
@@ -0,0 +1,134 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"keeplo/internal/domain/notification"
+	"keeplo/pkg/logger"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	dispatchTimeout    = 2 * time.Minute
+	defaultMaxAttempts = 3
+	defaultBackoff     = 2 * time.Second
+)
+
+// 모니터 상태 변화 이벤트를 연결된 채널로 전송하고 전송 이력을 기록
+type Dispatcher struct {
+	channelRepo  notification.ChannelRepository
+	deliveryRepo notification.DeliveryRepository
+	notifiers    map[string]notification.Notifier
+
+	maxAttempts int
+	backoff     time.Duration // 재시도마다 2배씩 증가
+}
+
+func NewDispatcher(cRepo notification.ChannelRepository, dRepo notification.DeliveryRepository, notifiers map[string]notification.Notifier) *Dispatcher {
+	return &Dispatcher{
+		channelRepo:  cRepo,
+		deliveryRepo: dRepo,
+		notifiers:    notifiers,
+		maxAttempts:  defaultMaxAttempts,
+		backoff:      defaultBackoff,
+	}
+}
+
+// 체크 흐름을 막지 않도록 별도 고루틴에서 전송
+func (d *Dispatcher) Publish(ctx context.Context, ev *notification.Event) {
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		ctx, cancel := context.WithTimeout(ctx, dispatchTimeout)
+		defer cancel()
+		d.Dispatch(ctx, ev)
+	}()
+}
+
+func (d *Dispatcher) Dispatch(ctx context.Context, ev *notification.Event) {
+	log := logger.WithContext(ctx)
+	monitorID := ev.Monitor.ID.String()
+
+	channels, err := d.channelRepo.FindByMonitorID(ctx, monitorID)
+	if err != nil {
+		log.Error("Dispatcher - failed to fetch channels", zap.String("monitor_id", monitorID), zap.Error(err))
+		return
+	}
+
+	for _, ch := range channels {
+		delivery := d.deliver(ctx, ch, ev)
+		if err := d.deliveryRepo.Create(ctx, delivery); err != nil {
+			log.Error("Dispatcher - failed to save delivery", zap.String("channel_id", ch.ID.String()), zap.Error(err))
+		}
+	}
+}
+
+// 재시도나 이력 기록 없이 테스트 메시지를 한 번 전송
+func (d *Dispatcher) SendTest(ctx context.Context, ch *notification.Channel) error {
+	n, ok := d.notifiers[ch.Type]
+	if !ok {
+		return fmt.Errorf("%w: %s", notification.ErrUnsupportedChannel, ch.Type)
+	}
+
+	return n.Send(ctx, ch, &notification.Event{
+		Type:       notification.EventTest,
+		Message:    "keeplo 알림 채널 테스트 메시지입니다.",
+		OccurredAt: time.Now(),
+	})
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, ch *notification.Channel, ev *notification.Event) *notification.Delivery {
+	log := logger.WithContext(ctx)
+	delivery := &notification.Delivery{
+		ID:         uuid.New(),
+		ChannelID:  ch.ID,
+		MonitorID:  ev.Monitor.ID,
+		IncidentID: ev.IncidentID,
+		Event:      ev.Type,
+		Status:     notification.DeliveryFailed,
+	}
+
+	n, ok := d.notifiers[ch.Type]
+	if !ok {
+		delivery.Error = fmt.Sprintf("%s: %s", notification.ErrUnsupportedChannel, ch.Type)
+		delivery.CreatedAt = time.Now()
+		log.Warn("Dispatcher - unsupported channel", zap.String("channel_id", ch.ID.String()), zap.String("type", ch.Type))
+		return delivery
+	}
+
+	wait := d.backoff
+	for attempt := 1; attempt <= d.maxAttempts; attempt++ {
+		delivery.Attempts = attempt
+
+		err := n.Send(ctx, ch, ev)
+		if err == nil {
+			delivery.Status = notification.DeliverySuccess
+			delivery.Error = ""
+			break
+		}
+
+		delivery.Error = err.Error()
+		log.Warn("Dispatcher - send failed",
+			zap.String("channel_id", ch.ID.String()),
+			zap.Int("attempt", attempt),
+			zap.Error(err),
+		)
+		if attempt == d.maxAttempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			delivery.Error = ctx.Err().Error()
+			delivery.CreatedAt = time.Now()
+			return delivery
+		case <-time.After(wait):
+		}
+		wait *= 2
+	}
+
+	delivery.CreatedAt = time.Now()
+	return delivery
+}
@@ -0,0 +1,49 @@
+package notification
+
+import (
+	"sync"
+	"time"
+)
+
+// 키별로 window 안의 호출 횟수를 limit 까지 허용하는 슬라이딩 윈도우 제한기
+type testLimiter struct {
+	mu     sync.Mutex
+	limit  int
+	window time.Duration
+	calls  map[string][]time.Time
+}
+
+func newTestLimiter(limit int, window time.Duration) *testLimiter {
+	return &testLimiter{
+		limit:  limit,
+		window: window,
+		calls:  make(map[string][]time.Time),
+	}
+}
+
+func (l *testLimiter) Allow(key string, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// 만료된 기록은 호출마다 정리해서 맵이 계속 커지지 않게 함
+	cutoff := now.Add(-l.window)
+	for k, times := range l.calls {
+		kept := times[:0]
+		for _, t := range times {
+			if t.After(cutoff) {
+				kept = append(kept, t)
+			}
+		}
+		if len(kept) == 0 {
+			delete(l.calls, k)
+		} else {
+			l.calls[k] = kept
+		}
+	}
+
+	if len(l.calls[key]) >= l.limit {
+		return false
+	}
+	l.calls[key] = append(l.calls[key], now)
+	return true
+}
@@ -0,0 +1,101 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"keeplo/internal/adapter/rest/dto"
+	"keeplo/internal/domain/monitor"
+	"keeplo/internal/domain/notification"
+	"keeplo/internal/domain/user"
+	"keeplo/pkg/logger"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	notificationTimeout = time.Second * 5
+
+	defaultDeliveryLimit = 50
+	maxDeliveryLimit     = 200
+)
+
+type Service interface {
+	RegisterChannel(ctx context.Context, userID string, req dto.RegisterChannelRequest) (*notification.Channel, error)
+	SearchChannelList(ctx context.Context, userID string) ([]*notification.Channel, error)
+	SearchChannel(ctx context.Context, id, userID string) (*notification.Channel, []string, error)
+	ModifyChannel(ctx context.Context, id, userID string, req dto.UpdateChannelRequest) error
+	DeleteChannel(ctx context.Context, id, userID string) error
+	TestChannel(ctx context.Context, id, userID string) error
+
+	AttachMonitor(ctx context.Context, channelID, monitorID, userID string) error
+	DetachMonitor(ctx context.Context, channelID, monitorID, userID string) error
+
+	SearchDeliveries(ctx context.Context, monitorID, userID string, limit int) ([]*notification.Delivery, error)
+}
+
+type notificationService struct {
+	monitorRepo  monitor.Repository
+	channelRepo  notification.ChannelRepository
+	deliveryRepo notification.DeliveryRepository
+	userRepo     user.Repository
+	dispatcher   *Dispatcher
+	testLimiter  *testLimiter
+}
+
+func NewNotificationService(mRepo monitor.Repository, cRepo notification.ChannelRepository, dRepo notification.DeliveryRepository, uRepo user.Repository, dispatcher *Dispatcher) Service {
+	return &notificationService{
+		monitorRepo:  mRepo,
+		channelRepo:  cRepo,
+		deliveryRepo: dRepo,
+		userRepo:     uRepo,
+		dispatcher:   dispatcher,
+		testLimiter:  newTestLimiter(channelTestLimit, channelTestWindow),
+	}
+}
+
+func (s *notificationService) SearchDeliveries(ctx context.Context, monitorID, userID string, limit int) ([]*notification.Delivery, error) {
+	ctx, cancel := context.WithTimeout(ctx, notificationTimeout)
+	defer cancel()
+
+	log := logger.WithContext(ctx)
+	log.Debug("SearchDeliveries - called", zap.String("monitor_id", monitorID), zap.String("user_id", userID))
+
+	// UUID 가 아닌 ID 는 DB 에서 형변환 오류가 나므로 조회 전에 걸러냄
+	if _, err := uuid.Parse(monitorID); err != nil {
+		log.Warn("SearchDeliveries - invalid monitor id", zap.String("monitor_id", monitorID))
+		return nil, monitor.ErrMonitorNotFound
+	}
+
+	monitorObj, err := s.monitorRepo.FindByID(ctx, monitorID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Warn("SearchDeliveries - monitor not found", zap.String("monitor_id", monitorID))
+			return nil, monitor.ErrMonitorNotFound
+		}
+		log.Error("SearchDeliveries - fetch monitor failed", zap.Error(err))
+		return nil, err
+	}
+	if monitorObj.UserID.String() != userID {
+		log.Warn("SearchDeliveries - permission denied", zap.String("monitor_id", monitorID), zap.String("user_id", userID))
+		return nil, monitor.ErrPermissionDenied
+	}
+
+	if limit <= 0 {
+		limit = defaultDeliveryLimit
+	}
+	if limit > maxDeliveryLimit {
+		limit = maxDeliveryLimit
+	}
+
+	deliveries, err := s.deliveryRepo.FindByMonitorID(ctx, monitorID, limit)
+	if err != nil {
+		log.Error("SearchDeliveries - failed", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("SearchDeliveries - success", zap.String("monitor_id", monitorID), zap.Int("count", len(deliveries)))
+	return deliveries, nil
+}
@@ -0,0 +1,10 @@
+package incident
+
+import "errors"
+
+var (
+	ErrIncidentNotFound    = errors.New("incident not found")
+	ErrIncidentResolved    = errors.New("incident already resolved")
+	ErrAlreadyAcknowledged = errors.New("incident already acknowledged")
+	ErrIncidentAlreadyOpen = errors.New("monitor already has an active incident")
+)
@@ -0,0 +1,55 @@
+package incident
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	StatusOpened       = "opened"
+	StatusAcknowledged = "acknowledged"
+	StatusResolved     = "resolved"
+)
+
+type Incident struct {
+	ID             uuid.UUID
+	MonitorID      uuid.UUID
+	Status         string     // "opened" | "acknowledged" | "resolved"
+	Cause          string     // 장애 원인 (인시던트를 연 헬스 로그의 메시지)
+	HealthLogIDs   []string   // 인시던트를 연 연속 실패 헬스 로그 ID (오래된 순)
+	StartedAt      time.Time  // 첫 실패 시각
+	AcknowledgedAt *time.Time // 담당자가 장애를 확인한 시각
+	ResolvedAt     *time.Time // 복구 확인 시각
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// 아직 복구되지 않은 인시던트인지
+func (i *Incident) IsActive() bool {
+	return i.Status != StatusResolved
+}
+
+// 담당자가 장애를 확인했음을 기록. 복구 판단은 그대로 진행
+func (i *Incident) Acknowledge(at time.Time) error {
+	if !i.IsActive() {
+		return ErrIncidentResolved
+	}
+	if i.Status == StatusAcknowledged {
+		return ErrAlreadyAcknowledged
+	}
+	i.Status = StatusAcknowledged
+	i.AcknowledgedAt = &at
+	i.UpdatedAt = at
+	return nil
+}
+
+func (i *Incident) Resolve(at time.Time) error {
+	if !i.IsActive() {
+		return ErrIncidentResolved
+	}
+	i.Status = StatusResolved
+	i.ResolvedAt = &at
+	i.UpdatedAt = at
+	return nil
+}
@@ -0,0 +1,10 @@
+package incident
+
+import "context"
+
+type Repository interface {
+	Create(ctx context.Context, i *Incident) error
+	Update(ctx context.Context, i *Incident) error
+	FindByID(ctx context.Context, id string) (*Incident, error)
+	FindActiveByMonitorID(ctx context.Context, monitorID string) (*Incident, error)
+}
@@ -8,4 +8,8 @@ var (
 	ErrInvalidMonitorData   = errors.New("invalid monitor data")
 	ErrMonitorAlreadyExists = errors.New("monitor already exists")
 	ErrMonitorInactive      = errors.New("monitor is inactive")
+	ErrUnsupportedProtocol  = errors.New("unsupported protocol")
+
+	ErrInvalidLogQuery = errors.New("invalid health log query")
+	ErrInvalidCursor   = errors.New("invalid cursor")
 )
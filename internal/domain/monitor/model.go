@@ -1,29 +1,115 @@
 package monitor
 
 import (
+	"net"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+const (
+	DefaultFailureThreshold  = 3
+	DefaultRecoveryThreshold = 2
+)
+
+// 헬스 로그 상태
+const (
+	StatusUp       = "up"
+	StatusDegraded = "degraded" // 응답은 있지만 느리거나 경고 상태. 인시던트를 열지 않음
+	StatusDown     = "down"
+
+	StatusUnknown = "unknown" // 이전 체크 기록이 없을 때 알림의 이전 상태로만 사용
+)
+
+// 대상에 접속하지 않고 작업이 보내는 핑을 기다리는 모니터 종류
+const TypeHeartbeat = "heartbeat"
+
+// 하트비트 핑 종류
+const (
+	PingStart   = "start"   // 작업 시작. 다음 success/fail 핑까지의 실행 시간을 측정
+	PingSuccess = "success" // 작업 성공
+	PingFail    = "fail"    // 작업 실패
+)
+
 type Monitor struct {
-	ID              uuid.UUID
-	UserID          uuid.UUID
-	Name            string
-	Target          string
-	Type            string
-	IntervalSeconds int
-	Enabled         bool
-	LastCheckedAt   *time.Time
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
+	ID                uuid.UUID
+	UserID            uuid.UUID
+	Name              string
+	Target            string
+	Type              string
+	IntervalSeconds   int
+	Enabled           bool
+	FailureThreshold  int // 연속 실패 N회 시 인시던트 생성
+	RecoveryThreshold int // 연속 성공 M회 시 인시던트 해소
+	TimeoutSeconds    int // 체크 타임아웃. 0 이면 기본값
+	DegradedMs        int // 응답 시간이 이 값(ms)을 넘으면 degraded. 0 이면 사용 안 함
+	Settings          Settings
+	PingToken         string     // 하트비트 핑 URL 토큰 (heartbeat 모니터만)
+	LastPingAt        *time.Time // 마지막 success/fail 핑 시각
+	RunStartedAt      *time.Time // 진행 중인 작업의 start 핑 시각
+	LastCheckedAt     *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
 }
 
 type HealthLog struct {
 	ID         string    // 로그 ID (Mongo ObjectID 문자열 또는 UUID 등)
 	MonitorID  string    // string으로 처리하여 양쪽 DB에서 모두 사용 가능
-	Status     string    // "up" | "down"
+	Status     string    // "up" | "degraded" | "down"
 	Message    string    // 실패 시 메시지
 	ResponseMs int       // 응답 시간 (ms)
 	Timestamp  time.Time // 체크된 시각
+
+	Timing *CheckTiming // 구간별 소요 시간. HTTP, TLS 체크만 기록
+}
+
+// 응답 시간의 구간별 소요 시간 (ms). 연결 재사용 등으로 구간이 없으면 0
+type CheckTiming struct {
+	DNSMs       float64 `json:"dns_ms"`
+	ConnectMs   float64 `json:"connect_ms"`
+	TLSMs       float64 `json:"tls_ms"`
+	FirstByteMs float64 `json:"first_byte_ms"` // 요청 전송 완료부터 첫 바이트까지
+	TransferMs  float64 `json:"transfer_ms"`   // 첫 바이트부터 본문 끝까지
+	RemoteIP    string  `json:"remote_ip,omitempty"`
+	StatusCode  int     `json:"status_code,omitempty"`
+	SizeBytes   int64   `json:"size_bytes,omitempty"`
+}
+
+// 헬스 로그 조회 조건 (커서 기반 페이지네이션)
+type HealthLogQuery struct {
+	MonitorIDs []string
+	Status     string     // 빈 값이면 전체
+	From       *time.Time // 포함
+	To         *time.Time // 미포함
+	After      *HealthLogCursor
+	Limit      int
+}
+
+// 마지막으로 조회한 로그 위치. Timestamp, ID 내림차순 기준으로 다음 페이지를 조회
+type HealthLogCursor struct {
+	Timestamp time.Time
+	ID        string
+}
+
+// 모니터와 가장 최근 헬스 로그 (체크 이력이 없으면 LastLog 는 nil)
+type MonitorStatus struct {
+	Monitor *Monitor
+	LastLog *HealthLog
+}
+
+func (m *Monitor) IsHeartbeat() bool {
+	return strings.EqualFold(m.Type, TypeHeartbeat)
+}
+
+// Target("scheme://host:port" 또는 "scheme://host")의 호스트와 포트. 하트비트처럼 접속 대상이 없으면 빈 값
+func (m *Monitor) HostPort() (host, port string) {
+	_, addr, ok := strings.Cut(m.Target, "://")
+	if !ok {
+		return "", ""
+	}
+	if h, p, err := net.SplitHostPort(addr); err == nil {
+		return h, p
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(addr, "["), "]"), ""
 }
@@ -1,12 +1,25 @@
 package monitor
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type Repository interface {
 	Create(ctx context.Context, m *Monitor) error
 	Update(ctx context.Context, m *Monitor) error
 	FindByUserID(ctx context.Context, userID string) ([]*Monitor, error)
 	FindByID(ctx context.Context, id string) (*Monitor, error)
+	FindAllEnabled(ctx context.Context) ([]*Monitor, error)
+	FindByPingToken(ctx context.Context, token string) (*Monitor, error)
 	SoftDelete(ctx context.Context, id string) error
 	HardDelete(ctx context.Context, id string) error
+	UpdateLastCheckedAt(ctx context.Context, id string, checkedAt time.Time) error
+	UpdatePingState(ctx context.Context, id string, lastPingAt, runStartedAt *time.Time) error
+}
+
+type HealthLogRepository interface {
+	Create(ctx context.Context, l *HealthLog) error
+	Find(ctx context.Context, q HealthLogQuery) ([]*HealthLog, error)
+	FindLatestByMonitorIDs(ctx context.Context, monitorIDs []string) ([]*HealthLog, error)
 }
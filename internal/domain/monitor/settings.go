@@ -0,0 +1,46 @@
+package monitor
+
+import "encoding/json"
+
+// 프로토콜별 체크 설정. 키는 checker 정의의 설정 블록 이름 (http, dns, banner 등)
+// 블록 형식과 검증은 checker 레지스트리가 담당하고, 모니터에 JSON 으로 함께 저장
+// 비밀번호, 토큰 같은 인증 정보는 저장소에서 암호화하고 조회 시 복호화
+type Settings map[string]json.RawMessage
+
+// 이름에 해당하는 설정 블록. 없으면 nil
+func (s Settings) Block(key string) json.RawMessage {
+	if key == "" {
+		return nil
+	}
+	return s[key]
+}
+
+// 블록을 교체한 사본. 원본 맵은 조회 결과끼리 공유될 수 있으므로 변경하지 않음
+func (s Settings) With(key string, raw json.RawMessage) Settings {
+	out := make(Settings, len(s)+1)
+	for k, v := range s {
+		out[k] = v
+	}
+	if raw == nil {
+		delete(out, key)
+	} else {
+		out[key] = raw
+	}
+	return out
+}
+
+// 하트비트 설정. 서버가 직접 체크하지 않는 모니터라 도메인에서 해석
+func (s Settings) Heartbeat() HeartbeatSettings {
+	var h HeartbeatSettings
+	if raw := s.Block(HeartbeatSettingsKey); len(raw) > 0 {
+		_ = json.Unmarshal(raw, &h)
+	}
+	return h
+}
+
+const HeartbeatSettingsKey = "heartbeat"
+
+// 마지막 핑 이후 주기 + 유예 시간 안에 다음 핑이 없으면 down
+type HeartbeatSettings struct {
+	GraceSeconds int `json:"grace_seconds,omitempty"`
+}
@@ -0,0 +1,12 @@
+package notification
+
+import "errors"
+
+var (
+	ErrUnsupportedChannel = errors.New("unsupported notification channel")
+	ErrChannelNotFound    = errors.New("notification channel not found")
+	ErrInvalidChannelData = errors.New("invalid notification channel data")
+	ErrPermissionDenied   = errors.New("permission denied")
+	ErrChannelTestFailed  = errors.New("notification channel test failed")
+	ErrTestRateLimited    = errors.New("too many notification channel tests")
+)
@@ -0,0 +1,65 @@
+package notification
+
+import (
+	"keeplo/internal/domain/monitor"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ChannelWebhook  = "webhook"
+	ChannelEmail    = "email"
+	ChannelSlack    = "slack"
+	ChannelDiscord  = "discord"
+	ChannelTelegram = "telegram"
+)
+
+const (
+	EventIncidentOpened   = "incident.opened"
+	EventIncidentResolved = "incident.resolved"
+	EventTest             = "notification.test"
+)
+
+const (
+	DeliverySuccess = "success"
+	DeliveryFailed  = "failed"
+)
+
+// 알림을 받을 곳 (채널 종류별로 Target 의미가 다름)
+type Channel struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Name      string
+	Type      string // "webhook" | "email" | "slack" | "discord" | "telegram"
+	Target    string // webhook/slack/discord: 수신 URL, email: 사용자 이메일 (비어 있으면 같은 주소), telegram: chat ID
+	Secret    string // webhook: 채널별 페이로드 서명 키 (생성 시 발급), telegram: 봇 토큰
+	Enabled   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// 모니터 상태 변화 이벤트
+type Event struct {
+	Type           string // "incident.opened" | "incident.resolved" | "notification.test"
+	Monitor        *monitor.Monitor
+	PreviousStatus string
+	NewStatus      string
+	IncidentID     string
+	ResponseMs     int
+	Message        string
+	OccurredAt     time.Time
+}
+
+// 채널별 알림 전송 이력
+type Delivery struct {
+	ID         uuid.UUID
+	ChannelID  uuid.UUID
+	MonitorID  uuid.UUID
+	IncidentID string
+	Event      string
+	Status     string // "success" | "failed"
+	Attempts   int
+	Error      string
+	CreatedAt  time.Time
+}
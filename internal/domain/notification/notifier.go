@@ -0,0 +1,8 @@
+package notification
+
+import "context"
+
+// 채널 종류별 전송 구현체
+type Notifier interface {
+	Send(ctx context.Context, ch *Channel, ev *Event) error
+}
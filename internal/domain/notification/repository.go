@@ -0,0 +1,21 @@
+package notification
+
+import "context"
+
+type ChannelRepository interface {
+	Create(ctx context.Context, ch *Channel) error
+	Update(ctx context.Context, ch *Channel) error
+	Delete(ctx context.Context, id string) error
+	FindByID(ctx context.Context, id string) (*Channel, error)
+	FindByUserID(ctx context.Context, userID string) ([]*Channel, error)
+	FindByMonitorID(ctx context.Context, monitorID string) ([]*Channel, error)
+
+	AttachMonitor(ctx context.Context, channelID, monitorID string) error
+	DetachMonitor(ctx context.Context, channelID, monitorID string) error
+	FindMonitorIDs(ctx context.Context, channelID string) ([]string, error)
+}
+
+type DeliveryRepository interface {
+	Create(ctx context.Context, d *Delivery) error
+	FindByMonitorID(ctx context.Context, monitorID string, limit int) ([]*Delivery, error)
+}
@@ -2,13 +2,22 @@ package scheduler
 
 import (
 	"context"
+	"errors"
 	"time"
 )
 
+// Refresher 가 돌려주면 Task 를 다시 스케줄하지 않고 버림
+var ErrTaskCanceled = errors.New("task canceled")
+
 type Executor interface {
 	Execute(ctx context.Context, playload any) error
 }
 
+// Executor 가 함께 구현하면 실행 직전에 Task 를 최신 상태로 다시 읽어옴
+type Refresher interface {
+	Refresh(ctx context.Context, task *Task) (*Task, error)
+}
+
 type Task struct {
 	ID          string
 	Executor    Executor
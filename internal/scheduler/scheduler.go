@@ -102,6 +102,19 @@ func handleTask(queueName string, task *Task) {
 		}
 	}()
 
+	if r, ok := task.Executor.(Refresher); ok {
+		refreshed, err := r.Refresh(context.Background(), task)
+		switch {
+		case errors.Is(err, ErrTaskCanceled):
+			log.Info("Task canceled, dropped from queue", zap.String("task_id", task.ID), zap.String("queue", queueName))
+			return
+		case err != nil:
+			log.Warn("Task refresh failed, using previous task", zap.String("task_id", task.ID), zap.Error(err))
+		default:
+			task = refreshed
+		}
+	}
+
 	if task.Executor != nil {
 		if err := task.Executor.Execute(context.Background(), task.Payload); err != nil {
 			log.Error("Task execution failed", zap.String("task_id", task.ID), zap.Error(err))
@@ -0,0 +1,198 @@
+package checker
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	AssertContains    = "contains"     // 본문에 Value 포함
+	AssertNotContains = "not_contains" // 본문에 Value 미포함
+	AssertRegex       = "regex"        // 본문이 정규식 Value 와 일치
+	AssertNotRegex    = "not_regex"    // 본문이 정규식 Value 와 불일치
+	AssertJSONPath    = "json_path"    // Target($.status 형식) 의 값이 Value 와 같음
+	AssertHeader      = "header"       // Target 헤더 값이 정규식 Value 와 일치
+)
+
+// 응답 본문 검사를 위해 읽어들이는 최대 크기
+const maxAssertBodyBytes = 1 << 20
+
+// HTTP 응답 검증 조건
+type Assertion struct {
+	Type   string `json:"type,omitempty"`
+	Target string `json:"target,omitempty"`
+	Value  string `json:"value,omitempty"`
+}
+
+// 조건 형식 검증 (정규식, JSON 경로 문법 등)
+func ValidateAssertions(assertions []Assertion) error {
+	for _, a := range assertions {
+		switch a.Type {
+		case AssertContains, AssertNotContains:
+			if a.Value == "" {
+				return fmt.Errorf("%s assertion requires a value", a.Type)
+			}
+		case AssertRegex, AssertNotRegex:
+			if _, err := regexp.Compile(a.Value); err != nil {
+				return fmt.Errorf("invalid regex %q: %w", a.Value, err)
+			}
+		case AssertJSONPath:
+			if _, err := parseJSONPath(a.Target); err != nil {
+				return err
+			}
+		case AssertHeader:
+			if a.Target == "" {
+				return errors.New("header assertion requires a header name")
+			}
+			if _, err := regexp.Compile(a.Value); err != nil {
+				return fmt.Errorf("invalid regex %q: %w", a.Value, err)
+			}
+		default:
+			return fmt.Errorf("unknown assertion type: %q", a.Type)
+		}
+	}
+	return nil
+}
+
+// 조건을 순서대로 검사해서 처음 실패한 조건의 사유를 반환
+func checkAssertions(assertions []Assertion, header http.Header, body []byte) error {
+	for _, a := range assertions {
+		if err := checkAssertion(a, header, body); err != nil {
+			return fmt.Errorf("assertion failed: %w", err)
+		}
+	}
+	return nil
+}
+
+func checkAssertion(a Assertion, header http.Header, body []byte) error {
+	switch a.Type {
+	case AssertContains:
+		if !bytes.Contains(body, []byte(a.Value)) {
+			return fmt.Errorf("body does not contain %q", a.Value)
+		}
+	case AssertNotContains:
+		if bytes.Contains(body, []byte(a.Value)) {
+			return fmt.Errorf("body contains %q", a.Value)
+		}
+	case AssertRegex, AssertNotRegex:
+		re, err := regexp.Compile(a.Value)
+		if err != nil {
+			return err
+		}
+		matched := re.Match(body)
+		if a.Type == AssertRegex && !matched {
+			return fmt.Errorf("body does not match /%s/", a.Value)
+		}
+		if a.Type == AssertNotRegex && matched {
+			return fmt.Errorf("body matches /%s/", a.Value)
+		}
+	case AssertJSONPath:
+		got, err := lookupJSONPath(body, a.Target)
+		if err != nil {
+			return err
+		}
+		if got != a.Value {
+			return fmt.Errorf("%s is %q, expected %q", a.Target, got, a.Value)
+		}
+	case AssertHeader:
+		re, err := regexp.Compile(a.Value)
+		if err != nil {
+			return err
+		}
+		got := header.Get(a.Target)
+		if !re.MatchString(got) {
+			return fmt.Errorf("header %s is %q, expected /%s/", a.Target, got, a.Value)
+		}
+	default:
+		return fmt.Errorf("unknown assertion type: %q", a.Type)
+	}
+	return nil
+}
+
+// JSON 본문에서 경로의 값을 문자열로 반환. 문자열은 따옴표 없이, 그 외 값은 JSON 표기 그대로
+func lookupJSONPath(body []byte, path string) (string, error) {
+	steps, err := parseJSONPath(path)
+	if err != nil {
+		return "", err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var cur any
+	if err := dec.Decode(&cur); err != nil {
+		return "", fmt.Errorf("body is not valid JSON: %w", err)
+	}
+
+	for _, step := range steps {
+		switch node := cur.(type) {
+		case map[string]any:
+			v, ok := node[step]
+			if !ok {
+				return "", fmt.Errorf("%s not found", path)
+			}
+			cur = v
+		case []any:
+			i, err := strconv.Atoi(step)
+			if err != nil || i < 0 || i >= len(node) {
+				return "", fmt.Errorf("%s not found", path)
+			}
+			cur = node[i]
+		default:
+			return "", fmt.Errorf("%s not found", path)
+		}
+	}
+
+	if s, ok := cur.(string); ok {
+		return s, nil
+	}
+	b, err := json.Marshal(cur)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// "$.data.items[0].status" -> ["data", "items", "0", "status"]
+func parseJSONPath(path string) ([]string, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("invalid json path %q: must start with $", path)
+	}
+
+	var steps []string
+	rest := path[1:]
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("invalid json path %q: empty key", path)
+			}
+			steps = append(steps, rest[:end])
+			rest = rest[end:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid json path %q: missing ]", path)
+			}
+			key := strings.Trim(rest[1:end], `'"`)
+			if key == "" {
+				return nil, fmt.Errorf("invalid json path %q: empty index", path)
+			}
+			steps = append(steps, key)
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("invalid json path %q", path)
+		}
+	}
+	return steps, nil
+}
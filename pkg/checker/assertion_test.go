@@ -0,0 +1,181 @@
+package checker
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAssertions(t *testing.T) {
+	tests := []struct {
+		name      string
+		assertion Assertion
+		wantErr   bool
+	}{
+		{"contains", Assertion{Type: AssertContains, Value: "ok"}, false},
+		{"contains without value", Assertion{Type: AssertContains}, true},
+		{"not_contains without value", Assertion{Type: AssertNotContains}, true},
+		{"regex", Assertion{Type: AssertRegex, Value: `^ok\d+$`}, false},
+		{"invalid regex", Assertion{Type: AssertRegex, Value: "("}, true},
+		{"invalid not_regex", Assertion{Type: AssertNotRegex, Value: "[a-"}, true},
+		{"json_path", Assertion{Type: AssertJSONPath, Target: "$.status", Value: "ok"}, false},
+		{"json_path without $", Assertion{Type: AssertJSONPath, Target: "status", Value: "ok"}, true},
+		{"header", Assertion{Type: AssertHeader, Target: "Content-Type", Value: "json"}, false},
+		{"header without name", Assertion{Type: AssertHeader, Value: "json"}, true},
+		{"header invalid regex", Assertion{Type: AssertHeader, Target: "X-Id", Value: "("}, true},
+		{"unknown type", Assertion{Type: "equals", Value: "x"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAssertions([]Assertion{tt.assertion})
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckAssertion(t *testing.T) {
+	body := []byte(`{"status":"ok","count":3,"data":{"items":[{"id":"a"},{"id":"b"}],"user.name":"kim"},"flag":true,"none":null}`)
+	header := http.Header{"Content-Type": {"application/json; charset=utf-8"}}
+
+	tests := []struct {
+		name      string
+		assertion Assertion
+		body      []byte
+		wantErr   string
+	}{
+		{"contains", Assertion{Type: AssertContains, Value: `"status":"ok"`}, body, ""},
+		{"contains fails", Assertion{Type: AssertContains, Value: "error"}, body, `body does not contain "error"`},
+		{"not_contains", Assertion{Type: AssertNotContains, Value: "error"}, body, ""},
+		{"not_contains fails", Assertion{Type: AssertNotContains, Value: "ok"}, body, `body contains "ok"`},
+		{"regex", Assertion{Type: AssertRegex, Value: `"count":\d+`}, body, ""},
+		{"regex fails", Assertion{Type: AssertRegex, Value: `"count":"\d+"`}, body, "body does not match"},
+		{"not_regex", Assertion{Type: AssertNotRegex, Value: `"error"`}, body, ""},
+		{"not_regex fails", Assertion{Type: AssertNotRegex, Value: `status`}, body, "body matches /status/"},
+		{"header", Assertion{Type: AssertHeader, Target: "content-type", Value: "^application/json"}, body, ""},
+		{"header fails", Assertion{Type: AssertHeader, Target: "Content-Type", Value: "^text/"}, body, "header Content-Type is"},
+		{"missing header", Assertion{Type: AssertHeader, Target: "X-Request-Id", Value: ".+"}, body, "header X-Request-Id is \"\""},
+		{"json_path string", Assertion{Type: AssertJSONPath, Target: "$.status", Value: "ok"}, body, ""},
+		{"json_path number", Assertion{Type: AssertJSONPath, Target: "$.count", Value: "3"}, body, ""},
+		{"json_path bool", Assertion{Type: AssertJSONPath, Target: "$.flag", Value: "true"}, body, ""},
+		{"json_path null", Assertion{Type: AssertJSONPath, Target: "$.none", Value: "null"}, body, ""},
+		{"json_path mismatch", Assertion{Type: AssertJSONPath, Target: "$.status", Value: "fail"}, body, `$.status is "ok", expected "fail"`},
+		{"json_path on non-JSON body", Assertion{Type: AssertJSONPath, Target: "$.status", Value: "ok"}, []byte("<html>"), "body is not valid JSON"},
+		{"unknown type", Assertion{Type: "equals"}, body, "unknown assertion type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkAssertion(tt.assertion, header, tt.body)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckAssertions_StopsAtFirstFailure(t *testing.T) {
+	err := checkAssertions([]Assertion{
+		{Type: AssertContains, Value: "ok"},
+		{Type: AssertContains, Value: "first"},
+		{Type: AssertContains, Value: "second"},
+	}, nil, []byte("ok"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "assertion failed")
+	assert.Contains(t, err.Error(), "first")
+	assert.NotContains(t, err.Error(), "second")
+}
+
+func TestLookupJSONPath(t *testing.T) {
+	body := []byte(`{"data":{"items":[{"id":"a","tags":["x","y"]},{"id":"b"}],"user.name":"kim","nested":{"ok":true}},"list":[1,2]}`)
+
+	tests := []struct {
+		path    string
+		want    string
+		wantErr string
+	}{
+		{path: "$", want: string(body)},
+		{path: "$.data.nested.ok", want: "true"},
+		{path: "$.data.nested", want: `{"ok":true}`},
+		{path: "$.data.items[0].id", want: "a"},
+		{path: "$.data.items[1].id", want: "b"},
+		{path: "$.data.items[0].tags[1]", want: "y"},
+		{path: "$.list[0]", want: "1"},
+		{path: "$.data['user.name']", want: "kim"},
+		{path: `$.data["user.name"]`, want: "kim"},
+		{path: "$['data']['nested']['ok']", want: "true"},
+		{path: "$.missing", wantErr: "$.missing not found"},
+		{path: "$.data.items[5].id", wantErr: "not found"},
+		{path: "$.data.items[-1]", wantErr: "not found"},
+		{path: "$.data.items.id", wantErr: "not found"},
+		{path: "$.list[0].id", wantErr: "not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := lookupJSONPath(body, tt.path)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if tt.path == "$" {
+				assert.JSONEq(t, tt.want, got)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLookupJSONPath_InvalidBody(t *testing.T) {
+	for _, body := range []string{"", "not json", "<html></html>", `{"a":`} {
+		_, err := lookupJSONPath([]byte(body), "$.a")
+		require.Error(t, err, body)
+		assert.Contains(t, err.Error(), "body is not valid JSON", body)
+	}
+}
+
+func TestParseJSONPath(t *testing.T) {
+	tests := []struct {
+		path    string
+		want    []string
+		wantErr string
+	}{
+		{path: "$", want: nil},
+		{path: "$.status", want: []string{"status"}},
+		{path: "$.data.items[0].status", want: []string{"data", "items", "0", "status"}},
+		{path: "$[0][1]", want: []string{"0", "1"}},
+		{path: "$['a.b'].c", want: []string{"a.b", "c"}},
+		{path: `$["a b"]`, want: []string{"a b"}},
+		{path: "status", wantErr: "must start with $"},
+		{path: "", wantErr: "must start with $"},
+		{path: "$.", wantErr: "empty key"},
+		{path: "$..a", wantErr: "empty key"},
+		{path: "$.a.[0]", wantErr: "empty key"},
+		{path: "$.items[0", wantErr: "missing ]"},
+		{path: "$[", wantErr: "missing ]"},
+		{path: "$[]", wantErr: "empty index"},
+		{path: "$['']", wantErr: "empty index"},
+		{path: "$a", wantErr: "invalid json path"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := parseJSONPath(tt.path)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package checker
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	maxBannerLines    = 20
+	defaultEHLOName   = "keeplo.local"
+	maxBannerInResult = 200
+)
+
+// 서버 인사말(배너)을 읽어서 확인하는 checker 공통 설정
+// 접속 지연과 인사말 수신 지연을 나눠서 Message 에 기록
+type BannerOptions struct {
+	Expect      string // 인사말 정규식. 비어 있으면 프로토콜 기본 형식만 확인
+	ImplicitTLS bool   // 465, 993, 995 처럼 접속부터 TLS 를 사용하는 경우
+}
+
+type SMTPChecker struct {
+	BannerOptions
+	EHLO     bool // 인사말 이후 EHLO 로 확장 기능 확인
+	StartTLS bool // EHLO 후 STARTTLS 로 TLS 전환까지 확인 (EHLO 포함)
+}
+
+type IMAPChecker struct{ BannerOptions }
+
+type POP3Checker struct{ BannerOptions }
+
+type SSHChecker struct{ BannerOptions }
+
+func (s *SMTPChecker) Check(ctx context.Context, target string) (*CheckResult, error) {
+	return checkBanner(ctx, target, "25", s.BannerOptions, func(conn *bannerConn) (string, error) {
+		greeting, err := conn.readSMTPReply(220)
+		if err != nil {
+			return "", err
+		}
+		if !s.EHLO && !s.StartTLS {
+			_ = conn.writeLine("QUIT")
+			return greeting, nil
+		}
+
+		ext, err := conn.ehlo()
+		if err != nil {
+			return greeting, err
+		}
+		if s.StartTLS {
+			if !strings.Contains(strings.ToUpper(ext), "STARTTLS") {
+				return greeting, errors.New("server does not advertise STARTTLS")
+			}
+			if err := conn.writeLine("STARTTLS"); err != nil {
+				return greeting, err
+			}
+			if _, err := conn.readSMTPReply(220); err != nil {
+				return greeting, fmt.Errorf("STARTTLS rejected: %w", err)
+			}
+			if err := conn.upgradeTLS(); err != nil {
+				return greeting, fmt.Errorf("STARTTLS handshake failed: %w", err)
+			}
+			if _, err := conn.ehlo(); err != nil {
+				return greeting, err
+			}
+		}
+		_ = conn.writeLine("QUIT")
+		return greeting, nil
+	})
+}
+
+func (i *IMAPChecker) Check(ctx context.Context, target string) (*CheckResult, error) {
+	return checkBanner(ctx, target, "143", i.BannerOptions, func(conn *bannerConn) (string, error) {
+		greeting, err := conn.readLine()
+		if err != nil {
+			return "", err
+		}
+		if !strings.HasPrefix(greeting, "* OK") && !strings.HasPrefix(greeting, "* PREAUTH") {
+			return greeting, fmt.Errorf("unexpected IMAP greeting: %q", greeting)
+		}
+		_ = conn.writeLine("a1 LOGOUT")
+		return greeting, nil
+	})
+}
+
+func (p *POP3Checker) Check(ctx context.Context, target string) (*CheckResult, error) {
+	return checkBanner(ctx, target, "110", p.BannerOptions, func(conn *bannerConn) (string, error) {
+		greeting, err := conn.readLine()
+		if err != nil {
+			return "", err
+		}
+		if !strings.HasPrefix(greeting, "+OK") {
+			return greeting, fmt.Errorf("unexpected POP3 greeting: %q", greeting)
+		}
+		_ = conn.writeLine("QUIT")
+		return greeting, nil
+	})
+}
+
+// SSH 서버는 식별 문자열 앞에 다른 줄을 보낼 수 있으므로 "SSH-" 로 시작하는 줄까지 읽음
+func (s *SSHChecker) Check(ctx context.Context, target string) (*CheckResult, error) {
+	return checkBanner(ctx, target, "22", s.BannerOptions, func(conn *bannerConn) (string, error) {
+		for range maxBannerLines {
+			line, err := conn.readLine()
+			if err != nil {
+				return "", err
+			}
+			if strings.HasPrefix(line, "SSH-") {
+				if !strings.HasPrefix(line, "SSH-2.0-") && !strings.HasPrefix(line, "SSH-1.99-") {
+					return line, fmt.Errorf("unsupported SSH protocol version: %q", line)
+				}
+				return line, nil
+			}
+		}
+		return "", errors.New("no SSH identification string")
+	})
+}
+
+type bannerConn struct {
+	net.Conn
+	r    *bufio.Reader
+	host string
+}
+
+// 접속 후 greet 로 프로토콜별 대화를 진행하고 인사말을 Expect 와 비교
+func checkBanner(ctx context.Context, target, defaultPort string, opts BannerOptions, greet func(*bannerConn) (string, error)) (*CheckResult, error) {
+	var expect *regexp.Regexp
+	if opts.Expect != "" {
+		re, err := regexp.Compile(opts.Expect)
+		if err != nil {
+			return &CheckResult{Status: "down", Message: err.Error()}, err
+		}
+		expect = re
+	}
+
+	host, port, err := splitTarget(target, defaultPort)
+	if err != nil {
+		return &CheckResult{Status: "down", Message: err.Error()}, err
+	}
+
+	start := time.Now()
+	dialer := net.Dialer{}
+	raw, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(host, port))
+	if err != nil {
+		msg := fmt.Sprintf("connection failed: %v", err)
+		return &CheckResult{Status: "down", Message: msg, ResponseMs: int(time.Since(start).Milliseconds())}, errors.New(msg)
+	}
+	defer raw.Close()
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(defaultTimeout)
+	}
+	_ = raw.SetDeadline(deadline)
+	stop := context.AfterFunc(ctx, func() { _ = raw.SetDeadline(time.Now()) })
+	defer stop()
+
+	conn := &bannerConn{Conn: raw, r: bufio.NewReader(raw), host: host}
+	if opts.ImplicitTLS {
+		if err := conn.upgradeTLS(); err != nil {
+			msg := fmt.Sprintf("tls handshake failed: %v", err)
+			return &CheckResult{Status: "down", Message: msg, ResponseMs: int(time.Since(start).Milliseconds())}, errors.New(msg)
+		}
+	}
+	connected := time.Since(start)
+
+	greeting, err := greet(conn)
+	total := time.Since(start)
+	timing := fmt.Sprintf("connect %dms, greeting %dms", connected.Milliseconds(), (total - connected).Milliseconds())
+
+	if err != nil {
+		msg := fmt.Sprintf("%s: %v", timing, err)
+		return &CheckResult{Status: "down", Message: msg, ResponseMs: int(total.Milliseconds())}, errors.New(msg)
+	}
+	if expect != nil && !expect.MatchString(greeting) {
+		msg := fmt.Sprintf("%s: greeting %q does not match /%s/", timing, truncateString(greeting, maxBannerInResult), opts.Expect)
+		return &CheckResult{Status: "down", Message: msg, ResponseMs: int(total.Milliseconds())}, errors.New(msg)
+	}
+
+	// SMTP 여러 줄 인사말은 한 줄로 합쳐서 기록
+	msg := fmt.Sprintf("%s: %s", timing, truncateString(strings.ReplaceAll(greeting, "\n", " "), maxBannerInResult))
+	return &CheckResult{Status: "up", Message: msg, ResponseMs: int(total.Milliseconds())}, nil
+}
+
+func (c *bannerConn) readLine() (string, error) {
+	line, err := c.r.ReadString('\n')
+	if err != nil {
+		if line != "" {
+			return strings.TrimRight(line, "\r\n"), nil
+		}
+		return "", fmt.Errorf("read greeting: %w", err)
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func (c *bannerConn) writeLine(line string) error {
+	_, err := c.Write([]byte(line + "\r\n"))
+	return err
+}
+
+// "250-..." 형식의 여러 줄 응답을 마지막 줄까지 읽고 코드를 확인
+func (c *bannerConn) readSMTPReply(code int) (string, error) {
+	var lines []string
+	for range maxBannerLines {
+		line, err := c.readLine()
+		if err != nil {
+			return strings.Join(lines, "\n"), err
+		}
+		lines = append(lines, line)
+
+		if len(line) < 3 || line[:3] != fmt.Sprint(code) {
+			return strings.Join(lines, "\n"), fmt.Errorf("unexpected SMTP reply: %q", line)
+		}
+		if len(line) == 3 || line[3] == ' ' {
+			return strings.Join(lines, "\n"), nil
+		}
+	}
+	return strings.Join(lines, "\n"), errors.New("SMTP reply too long")
+}
+
+func (c *bannerConn) ehlo() (string, error) {
+	if err := c.writeLine("EHLO " + defaultEHLOName); err != nil {
+		return "", err
+	}
+	ext, err := c.readSMTPReply(250)
+	if err != nil {
+		return "", fmt.Errorf("EHLO failed: %w", err)
+	}
+	return ext, nil
+}
+
+func (c *bannerConn) upgradeTLS() error {
+	tlsConn := tls.Client(c.Conn, &tls.Config{ServerName: c.host})
+	if err := tlsConn.Handshake(); err != nil {
+		return err
+	}
+	c.Conn = tlsConn
+	c.r = bufio.NewReader(tlsConn)
+	return nil
+}
+
+func truncateString(s string, n int) string {
+	if len(s) > n {
+		return s[:n] + "..."
+	}
+	return s
+}
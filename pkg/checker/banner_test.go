@@ -0,0 +1,199 @@
+package checker
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 접속하면 greeting 을 보내고, 받은 명령 줄의 첫 단어에 맞는 replies 로 응답하는 서버
+// 응답이 없는 명령이면 연결을 끊고, greeting 이 비어 있으면 인사말 없이 바로 끊음
+func startBannerServer(t *testing.T, greeting string, replies map[string]string) string {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				if greeting == "" {
+					return
+				}
+				if _, err := conn.Write([]byte(greeting)); err != nil {
+					return
+				}
+				rd := bufio.NewReader(conn)
+				for {
+					line, err := rd.ReadString('\n')
+					if err != nil {
+						return
+					}
+					reply, ok := replies[strings.Fields(line)[0]]
+					if !ok {
+						return
+					}
+					if _, err := conn.Write([]byte(reply)); err != nil {
+						return
+					}
+				}
+			}()
+		}
+	}()
+
+	return ln.Addr().String()
+}
+
+func newTestBannerConn(input string) *bannerConn {
+	return &bannerConn{r: bufio.NewReader(strings.NewReader(input))}
+}
+
+func TestBannerConn_ReadLine(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "crlf", input: "+OK ready\r\nnext\r\n", want: "+OK ready"},
+		{name: "lf", input: "* OK ready\n", want: "* OK ready"},
+		{name: "no newline", input: "SSH-2.0-OpenSSH_9.6", want: "SSH-2.0-OpenSSH_9.6"},
+		{name: "empty line", input: "\r\n", want: ""},
+		{name: "eof", input: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newTestBannerConn(tt.input).readLine()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBannerConn_ReadSMTPReply(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		code    int
+		want    string
+		wantErr string
+	}{
+		{name: "single line", input: "220 mail.example.com ESMTP\r\n", code: 220, want: "220 mail.example.com ESMTP"},
+		{name: "code only", input: "220\r\n", code: 220, want: "220"},
+		{
+			name:  "multi line",
+			input: "250-mail.example.com\r\n250-SIZE 35882577\r\n250 STARTTLS\r\n",
+			code:  250,
+			want:  "250-mail.example.com\n250-SIZE 35882577\n250 STARTTLS",
+		},
+		{name: "unexpected code", input: "554 no service\r\n", code: 220, want: "554 no service", wantErr: "unexpected SMTP reply"},
+		{name: "code changes mid reply", input: "250-first\r\n550 last\r\n", code: 250, want: "250-first\n550 last", wantErr: "unexpected SMTP reply"},
+		{name: "too short", input: "22\r\n", code: 220, want: "22", wantErr: "unexpected SMTP reply"},
+		{name: "truncated", input: "250-first\r\n", code: 250, want: "250-first", wantErr: "EOF"},
+		{name: "too long", input: strings.Repeat("250-ext\r\n", maxBannerLines+1), code: 250, wantErr: "SMTP reply too long"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newTestBannerConn(tt.input).readSMTPReply(tt.code)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				if tt.want != "" {
+					assert.Equal(t, tt.want, got)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTruncateString(t *testing.T) {
+	assert.Equal(t, "short", truncateString("short", 10))
+	assert.Equal(t, "exactly10!", truncateString("exactly10!", 10))
+	assert.Equal(t, "too lo...", truncateString("too long!", 6))
+}
+
+func TestBannerCheckers(t *testing.T) {
+	smtp := startBannerServer(t, "220-mail.keeplo.test ESMTP\r\n220 ready\r\n", map[string]string{
+		"EHLO": "250-mail.keeplo.test\r\n250-SIZE 35882577\r\n250 8BITMIME\r\n",
+		"QUIT": "221 bye\r\n",
+	})
+	smtpTLS := startBannerServer(t, "220 mail.keeplo.test ESMTP\r\n", map[string]string{
+		"EHLO":     "250-mail.keeplo.test\r\n250 STARTTLS\r\n",
+		"STARTTLS": "454 TLS not available due to temporary reason\r\n",
+	})
+	smtpBusy := startBannerServer(t, "554 too busy\r\n", nil)
+	imap := startBannerServer(t, "* OK [CAPABILITY IMAP4rev1] Dovecot ready.\r\n", map[string]string{"a1": "* BYE\r\na1 OK\r\n"})
+	imapPreauth := startBannerServer(t, "* PREAUTH ready\r\n", nil)
+	imapBye := startBannerServer(t, "* BYE too many connections\r\n", nil)
+	pop3 := startBannerServer(t, "+OK POP3 ready\r\n", map[string]string{"QUIT": "+OK bye\r\n"})
+	pop3Err := startBannerServer(t, "-ERR maintenance\r\n", nil)
+	ssh := startBannerServer(t, "SSH-2.0-OpenSSH_9.6\r\n", nil)
+	sshPrelude := startBannerServer(t, "Welcome\r\nAuthorized use only\r\nSSH-2.0-dropbear_2022.83\r\n", nil)
+	sshOld := startBannerServer(t, "SSH-1.5-legacy\r\n", nil)
+	sshCompat := startBannerServer(t, "SSH-1.99-Cisco-1.25\r\n", nil)
+	sshNone := startBannerServer(t, strings.Repeat("motd\r\n", maxBannerLines+1), nil)
+	silent := startBannerServer(t, "", nil)
+
+	tests := []struct {
+		name    string
+		checker Checker
+		target  string
+		wantUp  bool
+		wantMsg string
+	}{
+		{name: "smtp greeting", checker: &SMTPChecker{}, target: smtp, wantUp: true, wantMsg: "220-mail.keeplo.test ESMTP 220 ready"},
+		{name: "smtp scheme", checker: &SMTPChecker{}, target: "smtp://" + smtp, wantUp: true},
+		{name: "smtp ehlo", checker: &SMTPChecker{EHLO: true}, target: smtp, wantUp: true},
+		{name: "smtp expect match", checker: &SMTPChecker{BannerOptions: BannerOptions{Expect: "ESMTP"}}, target: smtp, wantUp: true},
+		{name: "smtp expect mismatch", checker: &SMTPChecker{BannerOptions: BannerOptions{Expect: "Postfix"}}, target: smtp, wantMsg: "does not match /Postfix/"},
+		{name: "smtp starttls not advertised", checker: &SMTPChecker{StartTLS: true}, target: smtp, wantMsg: "server does not advertise STARTTLS"},
+		{name: "smtp starttls rejected", checker: &SMTPChecker{StartTLS: true}, target: smtpTLS, wantMsg: "STARTTLS rejected"},
+		{name: "smtp error greeting", checker: &SMTPChecker{}, target: smtpBusy, wantMsg: "unexpected SMTP reply"},
+		{name: "smtp implicit tls on plaintext", checker: &SMTPChecker{BannerOptions: BannerOptions{ImplicitTLS: true}}, target: smtp, wantMsg: "tls handshake failed"},
+		{name: "imap ok", checker: &IMAPChecker{}, target: imap, wantUp: true, wantMsg: "Dovecot ready"},
+		{name: "imap preauth", checker: &IMAPChecker{}, target: imapPreauth, wantUp: true},
+		{name: "imap bye", checker: &IMAPChecker{}, target: imapBye, wantMsg: "unexpected IMAP greeting"},
+		{name: "pop3 ok", checker: &POP3Checker{}, target: pop3, wantUp: true},
+		{name: "pop3 error", checker: &POP3Checker{}, target: pop3Err, wantMsg: "unexpected POP3 greeting"},
+		{name: "ssh", checker: &SSHChecker{}, target: ssh, wantUp: true, wantMsg: "SSH-2.0-OpenSSH_9.6"},
+		{name: "ssh expect", checker: &SSHChecker{BannerOptions: BannerOptions{Expect: "^SSH-2.0-OpenSSH_9"}}, target: ssh, wantUp: true},
+		{name: "ssh lines before identification", checker: &SSHChecker{}, target: sshPrelude, wantUp: true, wantMsg: "dropbear"},
+		{name: "ssh 1.99 compatibility", checker: &SSHChecker{}, target: sshCompat, wantUp: true},
+		{name: "ssh 1.x", checker: &SSHChecker{}, target: sshOld, wantMsg: "unsupported SSH protocol version"},
+		{name: "ssh no identification", checker: &SSHChecker{}, target: sshNone, wantMsg: "no SSH identification string"},
+		{name: "closed without greeting", checker: &POP3Checker{}, target: silent, wantMsg: "read greeting"},
+		{name: "invalid expect", checker: &SSHChecker{BannerOptions: BannerOptions{Expect: "("}}, target: ssh, wantMsg: "error parsing regexp"},
+		{name: "empty host", checker: &SSHChecker{}, target: "ssh://:22", wantMsg: "empty host"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.checker.Check(context.Background(), tt.target)
+			if tt.wantUp {
+				require.NoError(t, err)
+				assert.Equal(t, "up", res.Status)
+			} else {
+				require.Error(t, err)
+				assert.Equal(t, "down", res.Status)
+			}
+			assert.Contains(t, res.Message, tt.wantMsg)
+		})
+	}
+}
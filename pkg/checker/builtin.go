@@ -0,0 +1,418 @@
+package checker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"time"
+)
+
+// 기본 제공 checker 등록
+func init() {
+	MustRegister(Definition{
+		Name:        "http",
+		Description: "HTTP 요청 후 상태 코드와 응답 검사",
+		Settings:    "http",
+		Schema:      httpSettings{},
+		Secrets:     []string{"basic_auth.password", "bearer_token", "headers"},
+		Validate:    validateHTTP,
+		New:         newHTTP,
+	})
+	MustRegister(Definition{
+		Name:        "https",
+		Description: "HTTPS 요청 후 상태 코드와 응답 검사",
+		Settings:    "http",
+		Schema:      httpSettings{},
+		Secrets:     []string{"basic_auth.password", "bearer_token", "headers"},
+		Validate:    validateHTTP,
+		New:         newHTTP,
+	})
+	MustRegister(Definition{
+		Name:        "tcp",
+		Description: "TCP 연결 확인",
+		New:         func(Config) (Checker, error) { return &TCPChecker{}, nil },
+	})
+	MustRegister(Definition{
+		Name:        "websocket",
+		Aliases:     []string{"ws"},
+		Description: "WebSocket 핸드셰이크와 메시지 응답 확인",
+		Settings:    "ws",
+		Schema:      WSChecker{},
+		Secrets:     []string{"headers"},
+		Validate: func(raw json.RawMessage) error {
+			var c WSChecker
+			if err := DecodeSettings(raw, &c); err != nil {
+				return err
+			}
+			if len(c.Message) > 4096 {
+				return errors.New("message must be at most 4096 bytes")
+			}
+			if len(c.Subprotocols) > 10 {
+				return errors.New("at most 10 subprotocols are allowed")
+			}
+			return validatePattern(c.Expect)
+		},
+		New: func(cfg Config) (Checker, error) {
+			c := &WSChecker{}
+			return c, DecodeSettings(cfg.Settings, c)
+		},
+	})
+	MustRegister(Definition{
+		Name:        "tls",
+		Description: "인증서 체인, 호스트명, 만료일 확인",
+		Settings:    "tls",
+		Schema:      TLSChecker{},
+		Validate: func(raw json.RawMessage) error {
+			var c TLSChecker
+			if err := DecodeSettings(raw, &c); err != nil {
+				return err
+			}
+			if err := validateRange("warn_days", c.WarnDays, 0, 365); err != nil {
+				return err
+			}
+			if err := validateRange("critical_days", c.CriticalDays, 0, 365); err != nil {
+				return err
+			}
+			if c.WarnDays > 0 && c.CriticalDays > 0 && c.CriticalDays > c.WarnDays {
+				return errors.New("critical_days must not exceed warn_days")
+			}
+			return nil
+		},
+		New: func(cfg Config) (Checker, error) {
+			c := &TLSChecker{}
+			return c, DecodeSettings(cfg.Settings, c)
+		},
+	})
+	MustRegister(Definition{
+		Name:        "dns",
+		Description: "DNS 레코드 조회 결과 확인",
+		Settings:    "dns",
+		Schema:      DNSChecker{},
+		HostOnly:    true,
+		Validate: func(raw json.RawMessage) error {
+			var c DNSChecker
+			if err := DecodeSettings(raw, &c); err != nil {
+				return err
+			}
+			switch c.recordType() {
+			case DNSRecordA, DNSRecordAAAA, DNSRecordCNAME, DNSRecordMX, DNSRecordTXT:
+			default:
+				return fmt.Errorf("unsupported record type: %q", c.RecordType)
+			}
+			if c.Resolver != "" {
+				if _, _, err := net.SplitHostPort(c.Resolver); err != nil {
+					return fmt.Errorf("resolver must be host:port: %w", err)
+				}
+			}
+			if len(c.Expected) > 20 {
+				return errors.New("at most 20 expected answers are allowed")
+			}
+			return nil
+		},
+		New: func(cfg Config) (Checker, error) {
+			c := &DNSChecker{}
+			return c, DecodeSettings(cfg.Settings, c)
+		},
+	})
+	MustRegister(Definition{
+		Name:        "icmp",
+		Aliases:     []string{"ping"},
+		Description: "ICMP echo 손실률과 RTT 확인",
+		Settings:    "ping",
+		Schema:      PingChecker{},
+		HostOnly:    true,
+		Validate: func(raw json.RawMessage) error {
+			var c PingChecker
+			if err := DecodeSettings(raw, &c); err != nil {
+				return err
+			}
+			if err := validateRange("count", c.Count, 0, 20); err != nil {
+				return err
+			}
+			if c.DegradedLoss < 0 || c.DegradedLoss > 100 || c.DownLoss < 0 || c.DownLoss > 100 {
+				return errors.New("loss thresholds must be between 0 and 100")
+			}
+			if c.DegradedLoss > 0 && c.DownLoss > 0 && c.DegradedLoss > c.DownLoss {
+				return errors.New("degraded_loss must not exceed down_loss")
+			}
+			return nil
+		},
+		New: func(cfg Config) (Checker, error) {
+			c := &PingChecker{}
+			return c, DecodeSettings(cfg.Settings, c)
+		},
+	})
+	MustRegister(Definition{
+		Name:        "udp",
+		Description: "UDP 요청과 응답 확인",
+		Settings:    "udp",
+		Schema:      UDPChecker{},
+		Validate: func(raw json.RawMessage) error {
+			var c UDPChecker
+			if err := DecodeSettings(raw, &c); err != nil {
+				return err
+			}
+			if len(c.Payload) > 1024 {
+				return errors.New("payload must be at most 1024 bytes")
+			}
+			return c.Validate()
+		},
+		New: func(cfg Config) (Checker, error) {
+			c := &UDPChecker{Timeout: cfg.Timeout}
+			return c, DecodeSettings(cfg.Settings, c)
+		},
+	})
+	MustRegister(Definition{
+		Name:        "grpc",
+		Description: "gRPC health checking protocol 확인",
+		Settings:    "grpc",
+		Schema:      GRPCChecker{},
+		Validate: func(raw json.RawMessage) error {
+			var c GRPCChecker
+			return DecodeSettings(raw, &c)
+		},
+		New: func(cfg Config) (Checker, error) {
+			c := &GRPCChecker{}
+			return c, DecodeSettings(cfg.Settings, c)
+		},
+	})
+	MustRegister(Definition{
+		Name:        "postgres",
+		Aliases:     []string{"postgresql"},
+		Description: "PostgreSQL 접속 후 쿼리 결과 확인",
+		Settings:    "postgres",
+		Schema:      PostgresChecker{},
+		Secrets:     []string{"password"},
+		Validate: func(raw json.RawMessage) error {
+			var c PostgresChecker
+			if err := DecodeSettings(raw, &c); err != nil {
+				return err
+			}
+			if c.Username == "" || c.Database == "" {
+				return errors.New("username and database are required")
+			}
+			switch c.SSLMode {
+			case "", "disable", "allow", "prefer", "require", "verify-ca", "verify-full":
+			default:
+				return fmt.Errorf("unsupported ssl_mode: %q", c.SSLMode)
+			}
+			if len(c.Query) > 1000 {
+				return errors.New("query must be at most 1000 bytes")
+			}
+			return validatePattern(c.Expect)
+		},
+		New: func(cfg Config) (Checker, error) {
+			c := &PostgresChecker{}
+			return c, DecodeSettings(cfg.Settings, c)
+		},
+	})
+	MustRegister(Definition{
+		Name:        "redis",
+		Description: "Redis PING 또는 INFO 응답 확인",
+		Settings:    "redis",
+		Schema:      RedisChecker{},
+		Secrets:     []string{"password"},
+		Validate: func(raw json.RawMessage) error {
+			var c RedisChecker
+			if err := DecodeSettings(raw, &c); err != nil {
+				return err
+			}
+			if err := validateRange("db", c.DB, 0, 15); err != nil {
+				return err
+			}
+			if cmd := c.command(); cmd != RedisCommandPing && cmd != RedisCommandInfo {
+				return fmt.Errorf("unsupported command: %q", c.Command)
+			}
+			return validatePattern(c.Expect)
+		},
+		New: func(cfg Config) (Checker, error) {
+			c := &RedisChecker{}
+			return c, DecodeSettings(cfg.Settings, c)
+		},
+	})
+	for _, p := range []struct{ name, desc string }{
+		{"smtp", "SMTP 인사말 확인 (EHLO, STARTTLS 선택)"},
+		{"imap", "IMAP 인사말 확인"},
+		{"pop3", "POP3 인사말 확인"},
+		{"ssh", "SSH 식별 문자열 확인"},
+	} {
+		MustRegister(Definition{
+			Name:        p.name,
+			Description: p.desc,
+			Settings:    "banner",
+			Schema:      bannerSettings{},
+			Validate:    validateBanner,
+			New:         bannerFactory(p.name),
+		})
+	}
+	MustRegister(Definition{
+		Name:        "transaction",
+		Description: "여러 HTTP 요청을 순서대로 실행하며 값 추출과 검사",
+		Settings:    "transaction",
+		Schema:      transactionSettings{},
+		Secrets:     []string{"secrets"},
+		Validate: func(raw json.RawMessage) error {
+			var s transactionSettings
+			if err := DecodeSettings(raw, &s); err != nil {
+				return err
+			}
+			for name := range s.Secrets {
+				if _, ok := s.Variables[name]; ok {
+					return fmt.Errorf("%q is defined in both variables and secrets", name)
+				}
+			}
+			return s.checker(0).Validate()
+		},
+		New: func(cfg Config) (Checker, error) {
+			var s transactionSettings
+			if err := DecodeSettings(cfg.Settings, &s); err != nil {
+				return nil, err
+			}
+			return s.checker(cfg.Timeout), nil
+		},
+	})
+}
+
+// HTTP 모니터 설정 블록
+type httpSettings struct {
+	Method              string            `json:"method,omitempty"`
+	Headers             map[string]string `json:"headers,omitempty"` // Authorization, X-API-Key 등이 들어가므로 값 전체를 인증 정보로 취급
+	Body                string            `json:"body,omitempty"`
+	BasicAuth           *BasicAuth        `json:"basic_auth,omitempty"`
+	BearerToken         string            `json:"bearer_token,omitempty"`
+	AcceptedStatusCodes []string          `json:"accepted_status_codes,omitempty"`
+	FollowRedirects     *bool             `json:"follow_redirects,omitempty"` // nil 이면 따라감
+	MaxRedirects        int               `json:"max_redirects,omitempty"`
+	Assertions          []Assertion       `json:"assertions,omitempty"`
+}
+
+func validateHTTP(raw json.RawMessage) error {
+	var s httpSettings
+	if err := DecodeSettings(raw, &s); err != nil {
+		return err
+	}
+	if err := validateMethod(s.Method); err != nil {
+		return err
+	}
+	if err := validateRange("max_redirects", s.MaxRedirects, 0, 20); err != nil {
+		return err
+	}
+	if _, err := ParseStatusRanges(s.AcceptedStatusCodes); err != nil {
+		return err
+	}
+	if len(s.Assertions) > maxAssertions {
+		return fmt.Errorf("at most %d assertions are allowed", maxAssertions)
+	}
+	if err := ValidateAssertions(s.Assertions); err != nil {
+		return err
+	}
+	if s.BasicAuth != nil && s.BearerToken != "" {
+		return errors.New("basic auth and bearer token are mutually exclusive")
+	}
+	return nil
+}
+
+func newHTTP(cfg Config) (Checker, error) {
+	var s httpSettings
+	if err := DecodeSettings(cfg.Settings, &s); err != nil {
+		return nil, err
+	}
+	return &HTTPChecker{
+		Method:              s.Method,
+		Headers:             s.Headers,
+		Body:                s.Body,
+		BasicAuth:           s.BasicAuth,
+		BearerToken:         s.BearerToken,
+		AcceptedStatusCodes: s.AcceptedStatusCodes,
+		DisableRedirects:    s.FollowRedirects != nil && !*s.FollowRedirects,
+		MaxRedirects:        s.MaxRedirects,
+		Timeout:             cfg.Timeout,
+		Assertions:          s.Assertions,
+	}, nil
+}
+
+// smtp, imap, pop3, ssh 공통 설정 블록
+type bannerSettings struct {
+	Expect   string `json:"expect,omitempty"`
+	TLS      bool   `json:"tls,omitempty"`       // 접속부터 TLS
+	EHLO     bool   `json:"ehlo,omitempty"`      // smtp 전용
+	StartTLS bool   `json:"start_tls,omitempty"` // smtp 전용
+}
+
+func validateBanner(raw json.RawMessage) error {
+	var s bannerSettings
+	if err := DecodeSettings(raw, &s); err != nil {
+		return err
+	}
+	if s.TLS && s.StartTLS {
+		return errors.New("tls and start_tls cannot be used together")
+	}
+	return validatePattern(s.Expect)
+}
+
+func bannerFactory(name string) func(Config) (Checker, error) {
+	return func(cfg Config) (Checker, error) {
+		var s bannerSettings
+		if err := DecodeSettings(cfg.Settings, &s); err != nil {
+			return nil, err
+		}
+
+		opts := BannerOptions{Expect: s.Expect, ImplicitTLS: s.TLS}
+		switch name {
+		case "smtp":
+			return &SMTPChecker{BannerOptions: opts, EHLO: s.EHLO, StartTLS: s.StartTLS}, nil
+		case "imap":
+			return &IMAPChecker{BannerOptions: opts}, nil
+		case "pop3":
+			return &POP3Checker{BannerOptions: opts}, nil
+		default:
+			return &SSHChecker{BannerOptions: opts}, nil
+		}
+	}
+}
+
+// 트랜잭션 설정 블록. Secrets 는 Variables 와 합쳐서 사용
+type transactionSettings struct {
+	TLS       bool              `json:"tls,omitempty"`
+	Variables map[string]string `json:"variables,omitempty"`
+	Secrets   map[string]string `json:"secrets,omitempty"`
+	Steps     []TransactionStep `json:"steps"`
+}
+
+func (s transactionSettings) checker(timeout time.Duration) *TransactionChecker {
+	vars := make(map[string]string, len(s.Variables)+len(s.Secrets))
+	for k, v := range s.Variables {
+		vars[k] = v
+	}
+	for k, v := range s.Secrets {
+		vars[k] = v
+	}
+	return &TransactionChecker{Steps: s.Steps, Variables: vars, Timeout: timeout, TLS: s.TLS}
+}
+
+func validatePattern(pattern string) error {
+	if _, err := regexp.Compile(pattern); err != nil {
+		return fmt.Errorf("invalid expect pattern: %w", err)
+	}
+	return nil
+}
+
+const maxAssertions = 20
+
+func validateMethod(method string) error {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodOptions:
+		return nil
+	}
+	return fmt.Errorf("unsupported method: %q", method)
+}
+
+func validateRange(name string, v, lo, hi int) error {
+	if v < lo || v > hi {
+		return fmt.Errorf("%s must be between %d and %d", name, lo, hi)
+	}
+	return nil
+}
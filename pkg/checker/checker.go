@@ -2,36 +2,29 @@ package checker
 
 import (
 	"context"
-	"fmt"
-	"strings"
 	"time"
 )
 
 const defaultTimeout = 5 * time.Second
 
 type CheckResult struct {
-	Status     string // "up" or "down"
+	Status     string // "up", "degraded" or "down"
 	Message    string // 실패 이유 (또는 비어있음)
 	ResponseMs int    // 응답 시간 (ms)
+
+	Timing *Timing      // 구간별 소요 시간 (HTTP, TLS)
+	Steps  []StepResult // 트랜잭션 체크의 단계별 결과
 }
 
 type Checker interface {
 	Check(ctx context.Context, target string) (*CheckResult, error)
 }
 
+// 레지스트리에 등록된 이름이나 별칭으로 기본 설정 checker 를 만들어 실행
 func RunHealthCheck(ctx context.Context, proto string, target string) (*CheckResult, error) {
-	var c Checker
-
-	switch strings.ToLower(proto) {
-	case "http", "https":
-		c = &HTTPChecker{}
-	case "tcp":
-		c = &TCPChecker{}
-	case "ws", "websocket":
-		c = &WSChecker{}
-	default:
-		return nil, fmt.Errorf("unsupported protocol: %s", proto)
+	c, err := New(proto, Config{})
+	if err != nil {
+		return nil, err
 	}
-
 	return c.Check(ctx, target)
 }
@@ -0,0 +1,139 @@
+package checker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	DNSRecordA     = "A"
+	DNSRecordAAAA  = "AAAA"
+	DNSRecordCNAME = "CNAME"
+	DNSRecordMX    = "MX"
+	DNSRecordTXT   = "TXT"
+)
+
+// 이름을 조회해서 응답이 Expected 와 같은 집합인지 확인
+// Expected 가 비어 있으면 응답이 하나 이상 있으면 정상
+type DNSChecker struct {
+	RecordType string   `json:"record_type,omitempty"` // 기본 A
+	Resolver   string   `json:"resolver,omitempty"`    // "host:port". 비어 있으면 시스템 리졸버
+	Expected   []string `json:"expected,omitempty"`    // A/AAAA 는 IP, CNAME/MX 는 호스트명, TXT 는 레코드 값
+}
+
+func (d *DNSChecker) Check(ctx context.Context, target string) (*CheckResult, error) {
+	name, err := targetHost(target)
+	if err != nil {
+		return &CheckResult{Status: "down", Message: err.Error()}, err
+	}
+
+	start := time.Now()
+	answers, err := d.lookup(ctx, name)
+	elapsed := int(time.Since(start).Milliseconds())
+	if err != nil {
+		msg := fmt.Sprintf("dns lookup failed: %v", err)
+		return &CheckResult{Status: "down", Message: msg, ResponseMs: elapsed}, errors.New(msg)
+	}
+
+	got := normalizeDNSAnswers(d.recordType(), answers)
+	summary := fmt.Sprintf("%s %s: %s", d.recordType(), name, strings.Join(got, ", "))
+
+	if len(got) == 0 {
+		msg := fmt.Sprintf("no %s records for %s", d.recordType(), name)
+		return &CheckResult{Status: "down", Message: msg, ResponseMs: elapsed}, errors.New(msg)
+	}
+	if len(d.Expected) > 0 {
+		want := normalizeDNSAnswers(d.recordType(), d.Expected)
+		if !slices.Equal(got, want) {
+			msg := fmt.Sprintf("unexpected answer: %s (expected %s)", summary, strings.Join(want, ", "))
+			return &CheckResult{Status: "down", Message: msg, ResponseMs: elapsed}, errors.New(msg)
+		}
+	}
+	return &CheckResult{Status: "up", Message: summary, ResponseMs: elapsed}, nil
+}
+
+func (d *DNSChecker) recordType() string {
+	if d.RecordType == "" {
+		return DNSRecordA
+	}
+	return strings.ToUpper(d.RecordType)
+}
+
+func (d *DNSChecker) resolver() *net.Resolver {
+	if d.Resolver == "" {
+		return net.DefaultResolver
+	}
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			dialer := net.Dialer{}
+			return dialer.DialContext(ctx, network, d.Resolver)
+		},
+	}
+}
+
+func (d *DNSChecker) lookup(ctx context.Context, name string) ([]string, error) {
+	r := d.resolver()
+
+	switch d.recordType() {
+	case DNSRecordA, DNSRecordAAAA:
+		network := "ip4"
+		if d.recordType() == DNSRecordAAAA {
+			network = "ip6"
+		}
+		ips, err := r.LookupIP(ctx, network, name)
+		if err != nil {
+			return nil, err
+		}
+		answers := make([]string, 0, len(ips))
+		for _, ip := range ips {
+			answers = append(answers, ip.String())
+		}
+		return answers, nil
+	case DNSRecordCNAME:
+		cname, err := r.LookupCNAME(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		return []string{cname}, nil
+	case DNSRecordMX:
+		mxs, err := r.LookupMX(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		answers := make([]string, 0, len(mxs))
+		for _, mx := range mxs {
+			answers = append(answers, mx.Host)
+		}
+		return answers, nil
+	case DNSRecordTXT:
+		return r.LookupTXT(ctx, name)
+	default:
+		return nil, fmt.Errorf("unsupported record type: %s", d.RecordType)
+	}
+}
+
+// 순서, 중복 차이를 무시하도록 정규화. TXT 외에는 대소문자와 마지막 점도 무시
+func normalizeDNSAnswers(recordType string, answers []string) []string {
+	out := make([]string, 0, len(answers))
+	for _, a := range answers {
+		a = strings.TrimSpace(a)
+		switch {
+		case recordType == DNSRecordTXT:
+		case net.ParseIP(a) != nil:
+			a = net.ParseIP(a).String()
+		default:
+			a = strings.TrimSuffix(strings.ToLower(a), ".")
+		}
+		if a != "" {
+			out = append(out, a)
+		}
+	}
+	slices.Sort(out)
+	return slices.Compact(out)
+}
@@ -0,0 +1,104 @@
+package checker
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// 고정된 레코드로 응답하는 UDP DNS 서버
+func startDNSServer(t *testing.T, records map[dnsmessage.Type][]dnsmessage.ResourceBody) string {
+	t.Helper()
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { pc.Close() })
+
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := pc.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+
+			var req dnsmessage.Message
+			if err := req.Unpack(buf[:n]); err != nil || len(req.Questions) == 0 {
+				continue
+			}
+			q := req.Questions[0]
+
+			res := dnsmessage.Message{
+				Header:    dnsmessage.Header{ID: req.ID, Response: true, Authoritative: true},
+				Questions: req.Questions,
+			}
+			bodies, ok := records[q.Type]
+			if !ok || !strings.EqualFold(q.Name.String(), "keeplo.test.") {
+				res.RCode = dnsmessage.RCodeNameError
+			}
+			for _, body := range bodies {
+				res.Answers = append(res.Answers, dnsmessage.Resource{
+					Header: dnsmessage.ResourceHeader{Name: q.Name, Type: q.Type, Class: dnsmessage.ClassINET, TTL: 60},
+					Body:   body,
+				})
+			}
+
+			packed, err := res.Pack()
+			if err != nil {
+				continue
+			}
+			_, _ = pc.WriteTo(packed, addr)
+		}
+	}()
+
+	return pc.LocalAddr().String()
+}
+
+func TestDNSChecker(t *testing.T) {
+	addr := startDNSServer(t, map[dnsmessage.Type][]dnsmessage.ResourceBody{
+		dnsmessage.TypeA: {
+			&dnsmessage.AResource{A: [4]byte{10, 0, 0, 2}},
+			&dnsmessage.AResource{A: [4]byte{10, 0, 0, 1}},
+		},
+		dnsmessage.TypeMX: {
+			&dnsmessage.MXResource{Pref: 10, MX: dnsmessage.MustNewName("mail.keeplo.test.")},
+		},
+		dnsmessage.TypeTXT: {
+			&dnsmessage.TXTResource{TXT: []string{"v=spf1 -all"}},
+		},
+	})
+
+	tests := []struct {
+		name     string
+		checker  DNSChecker
+		target   string
+		wantUp   bool
+		contains string
+	}{
+		{"A any answer", DNSChecker{Resolver: addr}, "dns://keeplo.test:53", true, "10.0.0.1, 10.0.0.2"},
+		{"A expected set ignores order", DNSChecker{Resolver: addr, Expected: []string{"10.0.0.2", "10.0.0.1"}}, "keeplo.test", true, ""},
+		{"A unexpected answer", DNSChecker{Resolver: addr, Expected: []string{"10.0.0.1"}}, "keeplo.test", false, "unexpected answer"},
+		{"MX", DNSChecker{Resolver: addr, RecordType: "mx", Expected: []string{"MAIL.keeplo.test."}}, "keeplo.test", true, ""},
+		{"TXT", DNSChecker{Resolver: addr, RecordType: DNSRecordTXT, Expected: []string{"v=spf1 -all"}}, "keeplo.test", true, ""},
+		{"NXDOMAIN", DNSChecker{Resolver: addr}, "missing.test", false, "dns lookup failed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.checker.Check(context.Background(), tt.target)
+			if tt.wantUp {
+				require.NoError(t, err)
+				assert.Equal(t, "up", result.Status)
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, "down", result.Status)
+			}
+			assert.Contains(t, result.Message, tt.contains)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package checker
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// grpc.health.v1.Health/Check 를 호출해서 서빙 상태를 확인
+// SERVING 은 up, UNKNOWN 은 degraded, 그 외는 down
+type GRPCChecker struct {
+	Service            string `json:"service,omitempty"` // 비어 있으면 서버 전체 상태
+	TLS                bool   `json:"tls,omitempty"`
+	InsecureSkipVerify bool   `json:"insecure_skip_verify,omitempty"` // TLS 사용 시 인증서 검증 생략
+}
+
+func (g *GRPCChecker) Check(ctx context.Context, target string) (*CheckResult, error) {
+	addr, err := targetAddr(target, "443")
+	if err != nil {
+		return &CheckResult{Status: "down", Message: err.Error()}, err
+	}
+
+	creds := insecure.NewCredentials()
+	if g.TLS {
+		creds = credentials.NewTLS(&tls.Config{InsecureSkipVerify: g.InsecureSkipVerify})
+	}
+
+	conn, err := grpc.NewClient("passthrough:///"+addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		msg := fmt.Sprintf("grpc client failed: %v", err)
+		return &CheckResult{Status: "down", Message: msg}, errors.New(msg)
+	}
+	defer conn.Close()
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultTimeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: g.Service})
+	elapsed := int(time.Since(start).Milliseconds())
+	if err != nil {
+		msg := fmt.Sprintf("grpc health check failed: %v", err)
+		if status.Code(err) == codes.Unimplemented {
+			msg = "grpc health service not implemented"
+		}
+		return &CheckResult{Status: "down", Message: msg, ResponseMs: elapsed}, errors.New(msg)
+	}
+
+	serving := resp.GetStatus()
+	msg := fmt.Sprintf("grpc health status %s", serving)
+	switch serving {
+	case healthpb.HealthCheckResponse_SERVING:
+		return &CheckResult{Status: "up", ResponseMs: elapsed}, nil
+	case healthpb.HealthCheckResponse_UNKNOWN:
+		return &CheckResult{Status: "degraded", Message: msg, ResponseMs: elapsed}, nil
+	default:
+		return &CheckResult{Status: "down", Message: msg, ResponseMs: elapsed}, errors.New(msg)
+	}
+}
@@ -0,0 +1,81 @@
+package checker
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// 서비스별 상태를 고정한 gRPC 서버. withHealth 가 false 면 health 서비스를 등록하지 않음
+func startGRPCServer(t *testing.T, withHealth bool, statuses map[string]healthpb.HealthCheckResponse_ServingStatus) string {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := grpc.NewServer()
+	if withHealth {
+		hs := health.NewServer()
+		for service, status := range statuses {
+			hs.SetServingStatus(service, status)
+		}
+		healthpb.RegisterHealthServer(srv, hs)
+	}
+	go func() { _ = srv.Serve(ln) }()
+	t.Cleanup(srv.Stop)
+
+	return ln.Addr().String()
+}
+
+func TestGRPCChecker(t *testing.T) {
+	addr := startGRPCServer(t, true, map[string]healthpb.HealthCheckResponse_ServingStatus{
+		"keeplo.Api":     healthpb.HealthCheckResponse_SERVING,
+		"keeplo.Worker":  healthpb.HealthCheckResponse_NOT_SERVING,
+		"keeplo.Pending": healthpb.HealthCheckResponse_UNKNOWN,
+	})
+	bare := startGRPCServer(t, false, nil)
+
+	tests := []struct {
+		name       string
+		checker    GRPCChecker
+		target     string
+		wantStatus string
+		wantMsg    string
+	}{
+		{name: "server serving", target: addr, wantStatus: "up"},
+		{name: "server with scheme", target: "grpc://" + addr, wantStatus: "up"},
+		{name: "service serving", checker: GRPCChecker{Service: "keeplo.Api"}, target: addr, wantStatus: "up"},
+		{name: "service not serving", checker: GRPCChecker{Service: "keeplo.Worker"}, target: addr, wantStatus: "down", wantMsg: "NOT_SERVING"},
+		{name: "service unknown status", checker: GRPCChecker{Service: "keeplo.Pending"}, target: addr, wantStatus: "degraded", wantMsg: "UNKNOWN"},
+		{name: "unregistered service", checker: GRPCChecker{Service: "keeplo.Missing"}, target: addr, wantStatus: "down", wantMsg: "NotFound"},
+		{name: "health not implemented", target: bare, wantStatus: "down", wantMsg: "not implemented"},
+		{name: "invalid target", target: "grpc://:50051", wantStatus: "down", wantMsg: "empty host"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.checker.Check(context.Background(), tt.target)
+			assert.Equal(t, tt.wantStatus, res.Status)
+			assert.Contains(t, res.Message, tt.wantMsg)
+			if tt.wantStatus == "down" {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGRPCChecker_TLSAgainstPlaintext(t *testing.T) {
+	addr := startGRPCServer(t, true, nil)
+
+	res, err := (&GRPCChecker{TLS: true, InsecureSkipVerify: true}).Check(context.Background(), addr)
+	require.Error(t, err)
+	assert.Equal(t, "down", res.Status)
+	assert.Contains(t, res.Message, "grpc health check failed")
+}
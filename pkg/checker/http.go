@@ -4,32 +4,159 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptrace"
+	"strconv"
+	"strings"
 	"time"
 )
 
-type HTTPChecker struct{}
+const (
+	defaultMaxRedirects = 10
+	maxTransferBytes    = 10 << 20 // 전송 시간 측정을 위해 읽는 최대 본문 크기
+)
+
+type BasicAuth struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// 설정하지 않은 값은 기존 동작(GET, 리다이렉트 추적, 400 미만이면 정상)을 따름
+type HTTPChecker struct {
+	Method              string            // 기본 GET
+	Headers             map[string]string //
+	Body                string            //
+	BasicAuth           *BasicAuth        //
+	BearerToken         string            //
+	AcceptedStatusCodes []string          // "200-299", "301" 형식. 비어 있으면 400 미만
+	DisableRedirects    bool              // true 면 3xx 응답을 그대로 판정
+	MaxRedirects        int               // 0 이면 10
+	Timeout             time.Duration     // 0 이면 defaultTimeout
+	Assertions          []Assertion       // 상태 코드 확인 후 순서대로 검사
+}
 
 func (h *HTTPChecker) Check(ctx context.Context, target string) (*CheckResult, error) {
-	start := time.Now()
+	accepted, err := ParseStatusRanges(h.AcceptedStatusCodes)
+	if err != nil {
+		return &CheckResult{Status: "down", Message: err.Error()}, err
+	}
+
+	method := h.Method
+	if method == "" {
+		method = http.MethodGet
+	}
 
-	client := http.Client{Timeout: defaultTimeout}
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	var body io.Reader
+	if h.Body != "" {
+		body = strings.NewReader(h.Body)
+	}
+
+	trace := &httpTrace{}
+	start := time.Now()
+	req, err := http.NewRequestWithContext(httptrace.WithClientTrace(ctx, trace.clientTrace()), method, target, body)
 	if err != nil {
-		return &CheckResult{"down", "invalid request", 0}, err
+		return &CheckResult{Status: "down", Message: "invalid request"}, err
+	}
+	for k, v := range h.Headers {
+		req.Header.Set(k, v)
+	}
+	if h.BasicAuth != nil {
+		req.SetBasicAuth(h.BasicAuth.Username, h.BasicAuth.Password)
+	}
+	if h.BearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+h.BearerToken)
 	}
 
-	resp, err := client.Do(req)
+	resp, err := h.client().Do(req)
 	elapsed := time.Since(start).Milliseconds()
 
 	if err != nil {
-		return &CheckResult{"down", err.Error(), int(elapsed)}, err
+		return &CheckResult{Status: "down", Message: err.Error(), ResponseMs: int(elapsed), Timing: trace.finish(0, 0)}, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode >= 400 {
+	// 전송 시간과 크기를 재기 위해 본문을 끝까지 읽고, 검사에 쓸 앞부분만 보관
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxAssertBodyBytes))
+	size := int64(len(respBody))
+	if err == nil {
+		var rest int64
+		rest, err = io.Copy(io.Discard, io.LimitReader(resp.Body, maxTransferBytes-size))
+		size += rest
+	}
+	timing := trace.finish(resp.StatusCode, size)
+	if err != nil {
+		msg := fmt.Sprintf("read body: %v", err)
+		return &CheckResult{Status: "down", Message: msg, ResponseMs: int(elapsed), Timing: timing}, errors.New(msg)
+	}
+
+	if !statusAccepted(accepted, resp.StatusCode) {
 		msg := fmt.Sprintf("HTTP status %d", resp.StatusCode)
-		return &CheckResult{"down", msg, int(elapsed)}, errors.New(msg)
+		return &CheckResult{Status: "down", Message: msg, ResponseMs: int(elapsed), Timing: timing}, errors.New(msg)
+	}
+	if err := checkAssertions(h.Assertions, resp.Header, respBody); err != nil {
+		return &CheckResult{Status: "down", Message: err.Error(), ResponseMs: int(elapsed), Timing: timing}, err
+	}
+	return &CheckResult{Status: "up", ResponseMs: int(elapsed), Timing: timing}, nil
+}
+
+func (h *HTTPChecker) client() *http.Client {
+	timeout := h.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	maxRedirects := h.MaxRedirects
+	if maxRedirects <= 0 {
+		maxRedirects = defaultMaxRedirects
+	}
+
+	// 검사마다 새로 연결해야 DNS, 연결, TLS 구간이 매번 측정됨
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DisableKeepAlives = true
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if h.DisableRedirects {
+				return http.ErrUseLastResponse
+			}
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxRedirects)
+			}
+			return nil
+		},
+	}
+}
+
+// "200-299", "301" 형식의 상태 코드 범위 파싱
+func ParseStatusRanges(codes []string) ([][2]int, error) {
+	ranges := make([][2]int, 0, len(codes))
+	for _, c := range codes {
+		lo, hi, isRange := strings.Cut(strings.TrimSpace(c), "-")
+		if !isRange {
+			hi = lo
+		}
+
+		from, err1 := strconv.Atoi(strings.TrimSpace(lo))
+		to, err2 := strconv.Atoi(strings.TrimSpace(hi))
+		if err1 != nil || err2 != nil || from < 100 || to > 599 || from > to {
+			return nil, fmt.Errorf("invalid status code range: %q", c)
+		}
+		ranges = append(ranges, [2]int{from, to})
+	}
+	return ranges, nil
+}
+
+func statusAccepted(ranges [][2]int, code int) bool {
+	if len(ranges) == 0 {
+		return code < 400
+	}
+	for _, r := range ranges {
+		if code >= r[0] && code <= r[1] {
+			return true
+		}
 	}
-	return &CheckResult{"up", "", int(elapsed)}, nil
+	return false
 }
@@ -0,0 +1,147 @@
+package checker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPChecker_Timing(t *testing.T) {
+	body := strings.Repeat("x", 4096)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	res, err := (&HTTPChecker{}).Check(context.Background(), srv.URL)
+	require.NoError(t, err)
+	require.NotNil(t, res.Timing)
+
+	assert.Equal(t, "up", res.Status)
+	assert.Equal(t, http.StatusAccepted, res.Timing.StatusCode)
+	assert.Equal(t, int64(len(body)), res.Timing.SizeBytes)
+	assert.Equal(t, "127.0.0.1", res.Timing.RemoteIP)
+	assert.GreaterOrEqual(t, res.Timing.FirstByteMs, 20.0)
+	assert.Zero(t, res.Timing.TLSMs)
+}
+
+func TestHTTPChecker_TimingOnFailure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "boom", http.StatusInternalServerError)
+	}))
+	t.Cleanup(srv.Close)
+
+	res, err := (&HTTPChecker{}).Check(context.Background(), srv.URL)
+	require.Error(t, err)
+	assert.Equal(t, "down", res.Status)
+	require.NotNil(t, res.Timing)
+	assert.Equal(t, http.StatusInternalServerError, res.Timing.StatusCode)
+}
+
+func TestHTTPChecker_TimingNotReused(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	checker := &HTTPChecker{}
+	for i := 0; i < 2; i++ {
+		res, err := checker.Check(context.Background(), srv.URL)
+		require.NoError(t, err)
+		require.NotNil(t, res.Timing)
+		assert.Greater(t, res.Timing.ConnectMs, 0.0, "check %d", i+1)
+	}
+}
+
+func TestParseStatusRanges(t *testing.T) {
+	tests := []struct {
+		name    string
+		codes   []string
+		want    [][2]int
+		wantErr bool
+	}{
+		{name: "empty", codes: nil, want: [][2]int{}},
+		{name: "single code", codes: []string{"301"}, want: [][2]int{{301, 301}}},
+		{name: "range", codes: []string{"200-299"}, want: [][2]int{{200, 299}}},
+		{name: "list", codes: []string{"200-299", "301", "404"}, want: [][2]int{{200, 299}, {301, 301}, {404, 404}}},
+		{name: "whitespace", codes: []string{" 200 - 204 ", "\t418\n"}, want: [][2]int{{200, 204}, {418, 418}}},
+		{name: "bounds", codes: []string{"100-599"}, want: [][2]int{{100, 599}}},
+		{name: "reversed range", codes: []string{"299-200"}, wantErr: true},
+		{name: "below 100", codes: []string{"99"}, wantErr: true},
+		{name: "above 599", codes: []string{"200-600"}, wantErr: true},
+		{name: "empty string", codes: []string{""}, wantErr: true},
+		{name: "garbage", codes: []string{"ok"}, wantErr: true},
+		{name: "half range", codes: []string{"200-"}, wantErr: true},
+		{name: "open range", codes: []string{"-299"}, wantErr: true},
+		{name: "wildcard", codes: []string{"2xx"}, wantErr: true},
+		{name: "too many parts", codes: []string{"200-250-299"}, wantErr: true},
+		{name: "one bad entry", codes: []string{"200", "abc"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseStatusRanges(tt.codes)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestStatusAccepted(t *testing.T) {
+	ranges := [][2]int{{200, 204}, {301, 301}}
+
+	tests := []struct {
+		name   string
+		ranges [][2]int
+		code   int
+		want   bool
+	}{
+		{"default 2xx", nil, 200, true},
+		{"default 3xx", nil, 302, true},
+		{"default 399", nil, 399, true},
+		{"default 4xx", nil, 400, false},
+		{"default 5xx", nil, 503, false},
+		{"range start", ranges, 200, true},
+		{"range end", ranges, 204, true},
+		{"after range", ranges, 205, false},
+		{"single code", ranges, 301, true},
+		{"next to single code", ranges, 302, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, statusAccepted(tt.ranges, tt.code))
+		})
+	}
+}
+
+func TestHTTPChecker_AcceptedStatusCodes(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(srv.Close)
+
+	res, err := (&HTTPChecker{}).Check(context.Background(), srv.URL)
+	require.Error(t, err)
+	assert.Equal(t, "down", res.Status)
+	assert.Equal(t, "HTTP status 404", res.Message)
+
+	res, err = (&HTTPChecker{AcceptedStatusCodes: []string{"200-299", "404"}}).Check(context.Background(), srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, "up", res.Status)
+
+	res, err = (&HTTPChecker{AcceptedStatusCodes: []string{"2xx"}}).Check(context.Background(), srv.URL)
+	require.Error(t, err)
+	assert.Equal(t, "down", res.Status)
+	assert.Contains(t, res.Message, "invalid status code range")
+}
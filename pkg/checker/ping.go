@@ -0,0 +1,259 @@
+package checker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+)
+
+const (
+	defaultPingCount        = 4
+	defaultPingInterval     = 200 * time.Millisecond
+	defaultPingTimeout      = time.Second
+	defaultPingDegradedLoss = 20
+	defaultPingDownLoss     = 100
+
+	protocolICMP     = 1
+	protocolIPv6ICMP = 58
+)
+
+// ICMP echo 를 여러 번 보내고 손실률과 RTT 를 보고
+// 리눅스 비특권 ICMP 소켓(ping_group_range)을 먼저 시도하고, 허용되지 않으면 raw 소켓을 사용
+type PingChecker struct {
+	Count        int           `json:"count,omitempty"`         // 기본 4
+	Interval     time.Duration `json:"-"`                       // 전송 간격. 기본 200ms
+	Timeout      time.Duration `json:"-"`                       // 패킷별 응답 대기 시간. 기본 1s
+	DegradedLoss float64       `json:"degraded_loss,omitempty"` // 손실률(%)이 이 값 이상이면 degraded. 기본 20
+	DownLoss     float64       `json:"down_loss,omitempty"`     // 손실률(%)이 이 값 이상이면 down. 기본 100
+}
+
+// raw 소켓은 프로세스의 모든 echo 응답을 받으므로 동시에 도는 체크끼리 ID 가 겹치지 않게 체크마다 새로 발급
+var pingIDs atomic.Uint32
+
+func init() {
+	pingIDs.Store(rand.Uint32())
+}
+
+func nextPingID() int {
+	return int(pingIDs.Add(1) & 0xffff)
+}
+
+type pingConn struct {
+	conn       *icmp.PacketConn
+	privileged bool // raw 소켓이면 커널이 ID 를 바꾸지 않으므로 ID 로 응답을 구분
+	ipv6       bool
+}
+
+func (p *PingChecker) Check(ctx context.Context, target string) (*CheckResult, error) {
+	ip, err := resolvePingTarget(ctx, target)
+	if err != nil {
+		return &CheckResult{Status: "down", Message: err.Error()}, err
+	}
+
+	pc, err := listenICMP(ip.To4() == nil)
+	if err != nil {
+		msg := fmt.Sprintf("icmp socket unavailable: %v", err)
+		return &CheckResult{Status: "down", Message: msg}, errors.New(msg)
+	}
+	defer pc.conn.Close()
+
+	count := p.Count
+	if count <= 0 {
+		count = defaultPingCount
+	}
+	interval := p.Interval
+	if interval <= 0 {
+		interval = defaultPingInterval
+	}
+
+	// 시퀀스도 체크마다 임의 값에서 시작해서 ID 가 한 바퀴 돌아 겹쳐도 응답이 섞이지 않게 함
+	id, seqBase := nextPingID(), rand.IntN(0x10000)
+	var rtts []time.Duration
+	sent := 0
+	for i := 0; i < count; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+			case <-time.After(interval):
+			}
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		sent++
+		rtt, err := p.echo(ctx, pc, ip, id, (seqBase+i)&0xffff)
+		if err == nil {
+			rtts = append(rtts, rtt)
+		}
+	}
+
+	// 기한이 끝나 보내지 못한 패킷은 손실로 치지 않음
+	return p.result(ip, sent, rtts)
+}
+
+func (p *PingChecker) echo(ctx context.Context, pc *pingConn, ip net.IP, id, seq int) (time.Duration, error) {
+	msg := icmp.Message{
+		Code: 0,
+		Body: &icmp.Echo{ID: id, Seq: seq, Data: []byte("keeplo-ping")},
+	}
+	proto := protocolICMP
+	msg.Type = ipv4.ICMPTypeEcho
+	if pc.ipv6 {
+		proto = protocolIPv6ICMP
+		msg.Type = ipv6.ICMPTypeEchoRequest
+	}
+
+	b, err := msg.Marshal(nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var dst net.Addr = &net.IPAddr{IP: ip}
+	if !pc.privileged {
+		dst = &net.UDPAddr{IP: ip}
+	}
+
+	timeout := p.Timeout
+	if timeout <= 0 {
+		timeout = defaultPingTimeout
+	}
+	deadline := time.Now().Add(timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := pc.conn.SetReadDeadline(deadline); err != nil {
+		return 0, err
+	}
+
+	start := time.Now()
+	if _, err := pc.conn.WriteTo(b, dst); err != nil {
+		return 0, err
+	}
+
+	buf := make([]byte, 1500)
+	for {
+		n, peer, err := pc.conn.ReadFrom(buf)
+		if err != nil {
+			return 0, err
+		}
+		if !peerIP(peer).Equal(ip) {
+			continue
+		}
+
+		reply, err := icmp.ParseMessage(proto, buf[:n])
+		if err != nil {
+			continue
+		}
+		if reply.Type != ipv4.ICMPTypeEchoReply && reply.Type != ipv6.ICMPTypeEchoReply {
+			continue
+		}
+		echo, ok := reply.Body.(*icmp.Echo)
+		if !ok || echo.Seq != seq || (pc.privileged && echo.ID != id) {
+			continue
+		}
+		return time.Since(start), nil
+	}
+}
+
+func (p *PingChecker) result(ip net.IP, sent int, rtts []time.Duration) (*CheckResult, error) {
+	degradedLoss, downLoss := p.DegradedLoss, p.DownLoss
+	if degradedLoss <= 0 {
+		degradedLoss = defaultPingDegradedLoss
+	}
+	if downLoss <= 0 {
+		downLoss = defaultPingDownLoss
+	}
+
+	if len(rtts) == 0 {
+		msg := fmt.Sprintf("%s: %d packets sent, 100%% packet loss", ip, sent)
+		return &CheckResult{Status: "down", Message: msg}, errors.New(msg)
+	}
+	loss := float64(sent-len(rtts)) * 100 / float64(sent)
+
+	minRTT, maxRTT, sum := rtts[0], rtts[0], time.Duration(0)
+	for _, r := range rtts {
+		minRTT = min(minRTT, r)
+		maxRTT = max(maxRTT, r)
+		sum += r
+	}
+	avgRTT := sum / time.Duration(len(rtts))
+
+	msg := fmt.Sprintf("%s: %d packets sent, %.0f%% packet loss, rtt min/avg/max = %.2f/%.2f/%.2f ms",
+		ip, sent, loss, durationMs(minRTT), durationMs(avgRTT), durationMs(maxRTT))
+	result := &CheckResult{Status: "up", Message: msg, ResponseMs: int(avgRTT.Milliseconds())}
+
+	switch {
+	case loss >= downLoss:
+		result.Status = "down"
+		return result, errors.New(msg)
+	case loss >= degradedLoss:
+		result.Status = "degraded"
+	}
+	return result, nil
+}
+
+func listenICMP(useIPv6 bool) (*pingConn, error) {
+	dgram, raw, addr := "udp4", "ip4:icmp", "0.0.0.0"
+	if useIPv6 {
+		dgram, raw, addr = "udp6", "ip6:ipv6-icmp", "::"
+	}
+
+	if conn, err := icmp.ListenPacket(dgram, addr); err == nil {
+		return &pingConn{conn: conn, ipv6: useIPv6}, nil
+	}
+
+	conn, err := icmp.ListenPacket(raw, addr)
+	if err != nil {
+		return nil, err
+	}
+	return &pingConn{conn: conn, privileged: true, ipv6: useIPv6}, nil
+}
+
+// "icmp://host:0", "host:port", "host" 형식에서 호스트를 추출해서 IP 로 변환
+func resolvePingTarget(ctx context.Context, target string) (net.IP, error) {
+	host, err := targetHost(target)
+	if err != nil {
+		return nil, err
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return ip, nil
+	}
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, fmt.Errorf("resolve %s: %w", host, err)
+	}
+	for _, a := range addrs {
+		if a.IP.To4() != nil {
+			return a.IP, nil
+		}
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("resolve %s: no address", host)
+	}
+	return addrs[0].IP, nil
+}
+
+func peerIP(addr net.Addr) net.IP {
+	switch a := addr.(type) {
+	case *net.IPAddr:
+		return a.IP
+	case *net.UDPAddr:
+		return a.IP
+	default:
+		return nil
+	}
+}
+
+func durationMs(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
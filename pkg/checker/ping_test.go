@@ -0,0 +1,172 @@
+package checker
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPingChecker_Result(t *testing.T) {
+	ms := func(n int) time.Duration { return time.Duration(n) * time.Millisecond }
+	ip := net.ParseIP("10.0.0.1")
+
+	tests := []struct {
+		name       string
+		checker    PingChecker
+		sent       int
+		rtts       []time.Duration
+		wantStatus string
+		wantMs     int
+		wantMsg    string
+		wantErr    bool
+	}{
+		{
+			name:       "no loss",
+			sent:       4,
+			rtts:       []time.Duration{ms(10), ms(20), ms(30), ms(40)},
+			wantStatus: "up",
+			wantMs:     25,
+			wantMsg:    "10.0.0.1: 4 packets sent, 0% packet loss, rtt min/avg/max = 10.00/25.00/40.00 ms",
+		},
+		{
+			name:       "loss over default degraded threshold",
+			sent:       4,
+			rtts:       []time.Duration{ms(10), ms(20), ms(30)},
+			wantStatus: "degraded",
+			wantMs:     20,
+			wantMsg:    "25% packet loss",
+		},
+		{
+			name:       "loss under custom degraded threshold",
+			checker:    PingChecker{DegradedLoss: 30},
+			sent:       4,
+			rtts:       []time.Duration{ms(10), ms(20), ms(30)},
+			wantStatus: "up",
+			wantMs:     20,
+		},
+		{
+			name:       "loss over custom down threshold",
+			checker:    PingChecker{DownLoss: 50},
+			sent:       4,
+			rtts:       []time.Duration{ms(10), ms(20)},
+			wantStatus: "down",
+			wantMs:     15,
+			wantMsg:    "50% packet loss",
+			wantErr:    true,
+		},
+		{
+			name:       "deadline before first packet",
+			sent:       0,
+			wantStatus: "down",
+			wantMsg:    "10.0.0.1: 0 packets sent, 100% packet loss",
+			wantErr:    true,
+		},
+		{
+			name:       "all lost",
+			sent:       3,
+			wantStatus: "down",
+			wantMsg:    "10.0.0.1: 3 packets sent, 100% packet loss",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.checker.result(ip, tt.sent, tt.rtts)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			require.NotNil(t, res)
+			assert.Equal(t, tt.wantStatus, res.Status)
+			assert.Equal(t, tt.wantMs, res.ResponseMs)
+			assert.Contains(t, res.Message, tt.wantMsg)
+		})
+	}
+}
+
+func TestNextPingID(t *testing.T) {
+	seen := make(map[int]bool)
+	for range 100 {
+		id := nextPingID()
+		assert.GreaterOrEqual(t, id, 0)
+		assert.LessOrEqual(t, id, 0xffff)
+		assert.False(t, seen[id], "duplicate id %d", id)
+		seen[id] = true
+	}
+}
+
+func TestResolvePingTarget(t *testing.T) {
+	for target, want := range map[string]string{
+		"icmp://127.0.0.1:0": "127.0.0.1",
+		"127.0.0.1":          "127.0.0.1",
+		"[::1]:0":            "::1",
+	} {
+		ip, err := resolvePingTarget(context.Background(), target)
+		require.NoError(t, err, target)
+		assert.Equal(t, want, ip.String(), target)
+	}
+
+	_, err := resolvePingTarget(context.Background(), "icmp://")
+	assert.Error(t, err)
+}
+
+// ICMP 소켓을 열 권한이 없는 환경에서는 건너뜀
+func TestPingChecker_Loopback(t *testing.T) {
+	pc, err := listenICMP(false)
+	if err != nil {
+		t.Skipf("icmp socket unavailable: %v", err)
+	}
+	pc.conn.Close()
+
+	res, err := (&PingChecker{Count: 2, Interval: 10 * time.Millisecond}).Check(context.Background(), "icmp://127.0.0.1:0")
+	require.NoError(t, err)
+	assert.Equal(t, "up", res.Status)
+	assert.Contains(t, res.Message, "0% packet loss")
+}
+
+// 같은 대상으로 동시에 보내도 각 체크가 자기 응답만 세야 함
+func TestPingChecker_ConcurrentLoopback(t *testing.T) {
+	pc, err := listenICMP(false)
+	if err != nil {
+		t.Skipf("icmp socket unavailable: %v", err)
+	}
+	pc.conn.Close()
+
+	const n = 4
+	results := make(chan *CheckResult, n)
+	for range n {
+		go func() {
+			res, _ := (&PingChecker{Count: 3, Interval: 5 * time.Millisecond}).Check(context.Background(), "127.0.0.1")
+			results <- res
+		}()
+	}
+	for range n {
+		res := <-results
+		require.NotNil(t, res)
+		assert.Equal(t, "up", res.Status, res.Message)
+		assert.Contains(t, res.Message, "3 packets sent, 0% packet loss")
+	}
+}
+
+// 기한 때문에 보내지 못한 패킷은 손실로 세지 않음
+func TestPingChecker_DeadlineBeforeCount(t *testing.T) {
+	pc, err := listenICMP(false)
+	if err != nil {
+		t.Skipf("icmp socket unavailable: %v", err)
+	}
+	pc.conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 250*time.Millisecond)
+	defer cancel()
+
+	res, err := (&PingChecker{Count: 10, Interval: 100 * time.Millisecond}).Check(ctx, "127.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, "up", res.Status, res.Message)
+	assert.Contains(t, res.Message, "0% packet loss")
+	assert.NotContains(t, res.Message, "10 packets sent")
+}
@@ -0,0 +1,115 @@
+package checker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const defaultPostgresQuery = "SELECT 1"
+
+// PostgreSQL 에 접속해서 읽기 전용 트랜잭션으로 쿼리를 실행
+// Expect 가 있으면 첫 행 첫 컬럼 값이 정규식과 일치해야 정상
+type PostgresChecker struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Database string `json:"database,omitempty"`
+	SSLMode  string `json:"ssl_mode,omitempty"` // disable, prefer, require, verify-full 등. 기본 prefer
+	Query    string `json:"query,omitempty"`    // 기본 SELECT 1
+	Expect   string `json:"expect,omitempty"`
+}
+
+func (p *PostgresChecker) Check(ctx context.Context, target string) (*CheckResult, error) {
+	var expect *regexp.Regexp
+	if p.Expect != "" {
+		re, err := regexp.Compile(p.Expect)
+		if err != nil {
+			return &CheckResult{Status: "down", Message: err.Error()}, err
+		}
+		expect = re
+	}
+
+	connString, err := p.connString(target)
+	if err != nil {
+		return &CheckResult{Status: "down", Message: err.Error()}, err
+	}
+
+	start := time.Now()
+	conn, err := pgx.Connect(ctx, connString)
+	if err != nil {
+		msg := fmt.Sprintf("postgres connection failed: %v", err)
+		return &CheckResult{Status: "down", Message: msg, ResponseMs: int(time.Since(start).Milliseconds())}, errors.New(msg)
+	}
+	defer conn.Close(context.WithoutCancel(ctx))
+
+	value, err := p.query(ctx, conn)
+	elapsed := int(time.Since(start).Milliseconds())
+	if err != nil {
+		msg := fmt.Sprintf("postgres query failed: %v", err)
+		return &CheckResult{Status: "down", Message: msg, ResponseMs: elapsed}, errors.New(msg)
+	}
+
+	if expect != nil && !expect.MatchString(value) {
+		msg := fmt.Sprintf("query result %q does not match /%s/", value, p.Expect)
+		return &CheckResult{Status: "down", Message: msg, ResponseMs: elapsed}, errors.New(msg)
+	}
+	return &CheckResult{Status: "up", ResponseMs: elapsed}, nil
+}
+
+// 사용자 쿼리가 데이터를 바꾸지 않도록 읽기 전용 트랜잭션 안에서 실행하고 롤백
+func (p *PostgresChecker) query(ctx context.Context, conn *pgx.Conn) (string, error) {
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback(context.WithoutCancel(ctx))
+
+	query := p.Query
+	if query == "" {
+		query = defaultPostgresQuery
+	}
+
+	rows, err := tx.Query(ctx, query)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	var value string
+	if rows.Next() {
+		values, err := rows.Values()
+		if err != nil {
+			return "", err
+		}
+		if len(values) > 0 {
+			value = fmt.Sprint(values[0])
+		}
+	}
+	return value, rows.Err()
+}
+
+func (p *PostgresChecker) connString(target string) (string, error) {
+	host, err := targetAddr(target, "5432")
+	if err != nil {
+		return "", err
+	}
+
+	sslMode := p.SSLMode
+	if sslMode == "" {
+		sslMode = "prefer"
+	}
+
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(p.Username, p.Password),
+		Host:     host,
+		Path:     "/" + p.Database,
+		RawQuery: url.Values{"sslmode": {sslMode}, "application_name": {"keeplo"}}.Encode(),
+	}
+	return u.String(), nil
+}
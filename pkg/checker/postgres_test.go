@@ -0,0 +1,253 @@
+package checker
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 평문 비밀번호 인증 후 모든 쿼리에 value 한 행으로 응답하는 PostgreSQL 서버
+// 쿼리에 "fail" 이 들어 있거나 트랜잭션이 읽기 전용이 아니면 오류로 응답
+func startPostgresServer(t *testing.T, password, value string) string {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				servePostgres(pgproto3.NewBackend(conn, conn), conn, password, value)
+			}()
+		}
+	}()
+
+	return ln.Addr().String()
+}
+
+func servePostgres(b *pgproto3.Backend, conn net.Conn, password, value string) {
+	startup, err := b.ReceiveStartupMessage()
+	if err != nil {
+		return
+	}
+	if _, ok := startup.(*pgproto3.SSLRequest); ok {
+		if _, err := conn.Write([]byte("N")); err != nil {
+			return
+		}
+		if startup, err = b.ReceiveStartupMessage(); err != nil {
+			return
+		}
+	}
+	if _, ok := startup.(*pgproto3.StartupMessage); !ok {
+		return
+	}
+
+	b.Send(&pgproto3.AuthenticationCleartextPassword{})
+	if err := b.Flush(); err != nil {
+		return
+	}
+	if err := b.SetAuthType(pgproto3.AuthTypeCleartextPassword); err != nil {
+		return
+	}
+	msg, err := b.Receive()
+	if err != nil {
+		return
+	}
+	if pw, ok := msg.(*pgproto3.PasswordMessage); !ok || pw.Password != password {
+		b.Send(&pgproto3.ErrorResponse{Severity: "FATAL", Code: "28P01", Message: "password authentication failed"})
+		_ = b.Flush()
+		return
+	}
+
+	b.Send(&pgproto3.AuthenticationOk{})
+	b.Send(&pgproto3.BackendKeyData{ProcessID: 1, SecretKey: 1})
+	b.Send(&pgproto3.ReadyForQuery{TxStatus: 'I'})
+	if err := b.Flush(); err != nil {
+		return
+	}
+
+	rowDesc := &pgproto3.RowDescription{Fields: []pgproto3.FieldDescription{
+		{Name: []byte("value"), DataTypeOID: 25, DataTypeSize: -1, TypeModifier: -1},
+	}}
+	txStatus := byte('I')
+	failed := false
+	for {
+		msg, err := b.Receive()
+		if err != nil {
+			return
+		}
+
+		switch msg := msg.(type) {
+		case *pgproto3.Query:
+			// pgx 는 BEGIN, ROLLBACK 을 단순 쿼리로 보냄
+			tag := strings.ToUpper(strings.Fields(msg.String)[0])
+			if tag == "BEGIN" && !strings.Contains(strings.ToLower(msg.String), "read only") {
+				b.Send(&pgproto3.ErrorResponse{Severity: "ERROR", Code: "25006", Message: "transaction is not read only"})
+				b.Send(&pgproto3.ReadyForQuery{TxStatus: txStatus})
+				break
+			}
+			if tag == "BEGIN" {
+				txStatus = 'T'
+			} else {
+				txStatus = 'I'
+			}
+			b.Send(&pgproto3.CommandComplete{CommandTag: []byte(tag)})
+			b.Send(&pgproto3.ReadyForQuery{TxStatus: txStatus})
+		case *pgproto3.Parse:
+			if failed = strings.Contains(msg.Query, "fail"); failed {
+				b.Send(&pgproto3.ErrorResponse{Severity: "ERROR", Code: "42601", Message: "syntax error"})
+				continue
+			}
+			b.Send(&pgproto3.ParseComplete{})
+		case *pgproto3.Describe:
+			if failed {
+				continue
+			}
+			if msg.ObjectType == 'S' {
+				b.Send(&pgproto3.ParameterDescription{})
+			}
+			b.Send(rowDesc)
+		case *pgproto3.Bind:
+			if !failed {
+				b.Send(&pgproto3.BindComplete{})
+			}
+		case *pgproto3.Execute:
+			if !failed {
+				b.Send(&pgproto3.DataRow{Values: [][]byte{[]byte(value)}})
+				b.Send(&pgproto3.CommandComplete{CommandTag: []byte("SELECT 1")})
+			}
+		case *pgproto3.Close:
+			b.Send(&pgproto3.CloseComplete{})
+		case *pgproto3.Sync:
+			if failed {
+				txStatus = 'E'
+			}
+			failed = false
+			b.Send(&pgproto3.ReadyForQuery{TxStatus: txStatus})
+		case *pgproto3.Terminate:
+			return
+		}
+		if err := b.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func TestPostgresChecker_ConnString(t *testing.T) {
+	tests := []struct {
+		name     string
+		checker  PostgresChecker
+		target   string
+		wantHost string
+		wantUser *url.Userinfo
+		wantDB   string
+		wantSSL  string
+		wantErr  bool
+	}{
+		{
+			name:     "default port and ssl mode",
+			checker:  PostgresChecker{Username: "keeplo", Password: "secret", Database: "app"},
+			target:   "db.example.com",
+			wantHost: "db.example.com:5432",
+			wantUser: url.UserPassword("keeplo", "secret"),
+			wantDB:   "/app",
+			wantSSL:  "prefer",
+		},
+		{
+			name:     "scheme and port",
+			checker:  PostgresChecker{Username: "keeplo", Database: "app", SSLMode: "require"},
+			target:   "postgres://db.example.com:6543",
+			wantHost: "db.example.com:6543",
+			wantUser: url.UserPassword("keeplo", ""),
+			wantDB:   "/app",
+			wantSSL:  "require",
+		},
+		{
+			name:     "special characters in password",
+			checker:  PostgresChecker{Username: "keeplo", Password: "p@ss/w:rd?#", Database: "app"},
+			target:   "[::1]:5432",
+			wantHost: "[::1]:5432",
+			wantUser: url.UserPassword("keeplo", "p@ss/w:rd?#"),
+			wantDB:   "/app",
+			wantSSL:  "prefer",
+		},
+		{name: "empty host", target: "postgres://:5432", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.checker.connString(tt.target)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			u, err := url.Parse(got)
+			require.NoError(t, err)
+			assert.Equal(t, "postgres", u.Scheme)
+			assert.Equal(t, tt.wantHost, u.Host)
+			assert.Equal(t, tt.wantUser.String(), u.User.String())
+			assert.Equal(t, tt.wantDB, u.Path)
+			assert.Equal(t, tt.wantSSL, u.Query().Get("sslmode"))
+			assert.Equal(t, "keeplo", u.Query().Get("application_name"))
+		})
+	}
+}
+
+func TestPostgresChecker(t *testing.T) {
+	addr := startPostgresServer(t, "secret", "42")
+
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedAddr := closed.Addr().String()
+	closed.Close()
+
+	base := PostgresChecker{Username: "keeplo", Password: "secret", Database: "app", SSLMode: "disable"}
+	with := func(f func(p *PostgresChecker)) PostgresChecker {
+		p := base
+		f(&p)
+		return p
+	}
+
+	tests := []struct {
+		name    string
+		checker PostgresChecker
+		target  string
+		wantUp  bool
+		wantMsg string
+	}{
+		{name: "default query", checker: base, target: addr, wantUp: true},
+		{name: "ssl prefer falls back", checker: with(func(p *PostgresChecker) { p.SSLMode = "" }), target: addr, wantUp: true},
+		{name: "expect match", checker: with(func(p *PostgresChecker) { p.Query = "SELECT count(*) FROM jobs"; p.Expect = `^\d+$` }), target: addr, wantUp: true},
+		{name: "expect mismatch", checker: with(func(p *PostgresChecker) { p.Expect = "^0$" }), target: addr, wantMsg: `query result "42" does not match`},
+		{name: "invalid expect", checker: with(func(p *PostgresChecker) { p.Expect = "(" }), target: addr, wantMsg: "error parsing regexp"},
+		{name: "query error", checker: with(func(p *PostgresChecker) { p.Query = "SELECT fail" }), target: addr, wantMsg: "postgres query failed"},
+		{name: "wrong password", checker: with(func(p *PostgresChecker) { p.Password = "wrong" }), target: addr, wantMsg: "password authentication failed"},
+		{name: "connection refused", checker: base, target: closedAddr, wantMsg: "postgres connection failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.checker.Check(context.Background(), tt.target)
+			if tt.wantUp {
+				require.NoError(t, err)
+				assert.Equal(t, "up", res.Status)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, "down", res.Status)
+			assert.Contains(t, res.Message, tt.wantMsg)
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package checker
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	RedisCommandPing = "PING"
+	RedisCommandInfo = "INFO"
+
+	maxRedisReplyBytes = 1 << 20
+)
+
+// RESP 로 Redis 에 접속해서 PING 또는 INFO 응답을 확인
+// PING 은 PONG 이면 정상, INFO 는 Expect 가 있으면 응답이 정규식과 일치해야 정상
+type RedisChecker struct {
+	Username string `json:"username,omitempty"` // ACL 사용자. 비어 있으면 AUTH <password>
+	Password string `json:"password,omitempty"`
+	DB       int    `json:"db,omitempty"`
+	Command  string `json:"command,omitempty"` // PING, INFO. 기본 PING
+	Expect   string `json:"expect,omitempty"`
+	TLS      bool   `json:"tls,omitempty"`
+}
+
+func (r *RedisChecker) Check(ctx context.Context, target string) (*CheckResult, error) {
+	addr, err := targetAddr(target, "6379")
+	if err != nil {
+		return &CheckResult{Status: "down", Message: err.Error()}, err
+	}
+
+	var expect *regexp.Regexp
+	if r.Expect != "" {
+		if expect, err = regexp.Compile(r.Expect); err != nil {
+			return &CheckResult{Status: "down", Message: err.Error()}, err
+		}
+	}
+
+	start := time.Now()
+	conn, err := r.dial(ctx, addr)
+	if err != nil {
+		msg := fmt.Sprintf("redis connection failed: %v", err)
+		return &CheckResult{Status: "down", Message: msg, ResponseMs: int(time.Since(start).Milliseconds())}, errors.New(msg)
+	}
+	defer conn.Close()
+
+	reply, err := r.exchange(conn)
+	elapsed := int(time.Since(start).Milliseconds())
+	if err != nil {
+		msg := fmt.Sprintf("redis: %v", err)
+		return &CheckResult{Status: "down", Message: msg, ResponseMs: elapsed}, errors.New(msg)
+	}
+
+	if r.command() == RedisCommandPing && expect == nil && reply != "PONG" {
+		msg := fmt.Sprintf("unexpected PING reply: %q", reply)
+		return &CheckResult{Status: "down", Message: msg, ResponseMs: elapsed}, errors.New(msg)
+	}
+	if expect != nil && !expect.MatchString(reply) {
+		msg := fmt.Sprintf("%s reply does not match /%s/", r.command(), r.Expect)
+		return &CheckResult{Status: "down", Message: msg, ResponseMs: elapsed}, errors.New(msg)
+	}
+	return &CheckResult{Status: "up", ResponseMs: elapsed}, nil
+}
+
+func (r *RedisChecker) command() string {
+	if r.Command == "" {
+		return RedisCommandPing
+	}
+	return strings.ToUpper(r.Command)
+}
+
+func (r *RedisChecker) dial(ctx context.Context, addr string) (net.Conn, error) {
+	var conn net.Conn
+	var err error
+	if r.TLS {
+		host, _, _ := net.SplitHostPort(addr)
+		dialer := tls.Dialer{Config: &tls.Config{ServerName: host}}
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	} else {
+		dialer := net.Dialer{}
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	} else {
+		_ = conn.SetDeadline(time.Now().Add(defaultTimeout))
+	}
+	return conn, nil
+}
+
+// 인증, DB 선택 후 명령 실행
+func (r *RedisChecker) exchange(conn net.Conn) (string, error) {
+	rd := bufio.NewReader(conn)
+
+	if r.Password != "" {
+		args := []string{"AUTH", r.Password}
+		if r.Username != "" {
+			args = []string{"AUTH", r.Username, r.Password}
+		}
+		if _, err := redisCall(conn, rd, args...); err != nil {
+			return "", fmt.Errorf("AUTH failed: %w", err)
+		}
+	}
+	if r.DB > 0 {
+		if _, err := redisCall(conn, rd, "SELECT", strconv.Itoa(r.DB)); err != nil {
+			return "", fmt.Errorf("SELECT failed: %w", err)
+		}
+	}
+
+	cmd := r.command()
+	switch cmd {
+	case RedisCommandPing, RedisCommandInfo:
+	default:
+		return "", fmt.Errorf("unsupported command: %s", cmd)
+	}
+
+	reply, err := redisCall(conn, rd, cmd)
+	if err != nil {
+		return "", fmt.Errorf("%s failed: %w", cmd, err)
+	}
+	return reply, nil
+}
+
+func redisCall(w io.Writer, rd *bufio.Reader, args ...string) (string, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "*%d\r\n", len(args))
+	for _, a := range args {
+		fmt.Fprintf(&b, "$%d\r\n%s\r\n", len(a), a)
+	}
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return "", err
+	}
+	return readRESP(rd)
+}
+
+// 단순 문자열, 에러, 정수, 벌크 문자열 응답만 처리
+func readRESP(rd *bufio.Reader) (string, error) {
+	line, err := rd.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	line = strings.TrimSuffix(line, "\r\n")
+	if line == "" {
+		return "", errors.New("empty reply")
+	}
+
+	switch line[0] {
+	case '+', ':':
+		return line[1:], nil
+	case '-':
+		return "", errors.New(line[1:])
+	case '$':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return "", fmt.Errorf("invalid bulk length: %q", line)
+		}
+		if n < 0 {
+			return "", nil
+		}
+		if n > maxRedisReplyBytes {
+			return "", fmt.Errorf("reply too large: %d bytes", n)
+		}
+		buf := make([]byte, n+2)
+		if _, err := io.ReadFull(rd, buf); err != nil {
+			return "", err
+		}
+		return string(buf[:n]), nil
+	default:
+		return "", fmt.Errorf("unexpected reply: %q", line)
+	}
+}
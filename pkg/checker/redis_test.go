@@ -0,0 +1,188 @@
+package checker
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// PING, INFO, AUTH, SELECT 만 처리하는 Redis 서버. password 가 있으면 AUTH 전 명령은 거부
+func startRedisServer(t *testing.T, username, password string) string {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveRedis(conn, username, password)
+		}
+	}()
+
+	return ln.Addr().String()
+}
+
+func serveRedis(conn net.Conn, username, password string) {
+	defer conn.Close()
+	rd := bufio.NewReader(conn)
+	authed := password == ""
+
+	for {
+		args, err := readRedisCommand(rd)
+		if err != nil {
+			return
+		}
+
+		var reply string
+		switch cmd := strings.ToUpper(args[0]); {
+		case cmd == "AUTH":
+			user, pass := "default", args[len(args)-1]
+			if len(args) == 3 {
+				user = args[1]
+			}
+			if authed = pass == password && (len(args) == 2 || user == username); authed {
+				reply = "+OK\r\n"
+			} else {
+				reply = "-WRONGPASS invalid username-password pair\r\n"
+			}
+		case !authed:
+			reply = "-NOAUTH Authentication required.\r\n"
+		case cmd == "SELECT":
+			if args[1] == "15" {
+				reply = "+OK\r\n"
+			} else {
+				reply = "-ERR DB index is out of range\r\n"
+			}
+		case cmd == "PING":
+			reply = "+PONG\r\n"
+		case cmd == "INFO":
+			info := "# Server\r\nredis_version:7.2.4\r\nrole:master\r\n"
+			reply = fmt.Sprintf("$%d\r\n%s\r\n", len(info), info)
+		default:
+			reply = fmt.Sprintf("-ERR unknown command '%s'\r\n", args[0])
+		}
+		if _, err := conn.Write([]byte(reply)); err != nil {
+			return
+		}
+	}
+}
+
+// 클라이언트가 보낸 "*<n>\r\n$<len>\r\n<arg>\r\n..." 형식 명령
+func readRedisCommand(rd *bufio.Reader) ([]string, error) {
+	var n int
+	if _, err := fmt.Fscanf(rd, "*%d\r\n", &n); err != nil {
+		return nil, err
+	}
+	args := make([]string, n)
+	for i := range args {
+		var size int
+		if _, err := fmt.Fscanf(rd, "$%d\r\n", &size); err != nil {
+			return nil, err
+		}
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(rd, buf); err != nil {
+			return nil, err
+		}
+		args[i] = string(buf[:size])
+	}
+	return args, nil
+}
+
+func TestReadRESP(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr string
+	}{
+		{name: "simple string", input: "+PONG\r\n", want: "PONG"},
+		{name: "integer", input: ":42\r\n", want: "42"},
+		{name: "error", input: "-NOAUTH Authentication required.\r\n", wantErr: "NOAUTH Authentication required."},
+		{name: "bulk string", input: "$5\r\nhello\r\n", want: "hello"},
+		{name: "bulk string with newline", input: "$12\r\nrole:master\n\r\n", want: "role:master\n"},
+		{name: "empty bulk string", input: "$0\r\n\r\n", want: ""},
+		{name: "null bulk string", input: "$-1\r\n", want: ""},
+		{name: "invalid bulk length", input: "$abc\r\n", wantErr: "invalid bulk length"},
+		{name: "bulk too large", input: fmt.Sprintf("$%d\r\n", maxRedisReplyBytes+1), wantErr: "reply too large"},
+		{name: "truncated bulk", input: "$10\r\nshort\r\n", wantErr: "EOF"},
+		{name: "array unsupported", input: "*1\r\n$4\r\nPONG\r\n", wantErr: "unexpected reply"},
+		{name: "empty line", input: "\r\n", wantErr: "empty reply"},
+		{name: "no newline", input: "+PONG", wantErr: "EOF"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readRESP(bufio.NewReader(strings.NewReader(tt.input)))
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRedisCall_Encoding(t *testing.T) {
+	var sent bytes.Buffer
+	reply, err := redisCall(&sent, bufio.NewReader(strings.NewReader("+OK\r\n")), "AUTH", "keeplo", "p@ss word")
+	require.NoError(t, err)
+	assert.Equal(t, "OK", reply)
+	assert.Equal(t, "*3\r\n$4\r\nAUTH\r\n$6\r\nkeeplo\r\n$9\r\np@ss word\r\n", sent.String())
+}
+
+func TestRedisChecker(t *testing.T) {
+	open := startRedisServer(t, "", "")
+	secured := startRedisServer(t, "monitor", "secret")
+
+	tests := []struct {
+		name    string
+		checker RedisChecker
+		target  string
+		wantUp  bool
+		wantMsg string
+	}{
+		{name: "ping", target: open, wantUp: true},
+		{name: "ping with scheme", target: "redis://" + open, wantUp: true},
+		{name: "lowercase command", checker: RedisChecker{Command: "ping"}, target: open, wantUp: true},
+		{name: "info", checker: RedisChecker{Command: RedisCommandInfo}, target: open, wantUp: true},
+		{name: "info expect match", checker: RedisChecker{Command: RedisCommandInfo, Expect: `role:master`}, target: open, wantUp: true},
+		{name: "info expect mismatch", checker: RedisChecker{Command: RedisCommandInfo, Expect: `role:slave`}, target: open, wantMsg: "INFO reply does not match"},
+		{name: "unsupported command", checker: RedisChecker{Command: "FLUSHALL"}, target: open, wantMsg: "unsupported command: FLUSHALL"},
+		{name: "select db", checker: RedisChecker{DB: 15}, target: open, wantUp: true},
+		{name: "select db failed", checker: RedisChecker{DB: 3}, target: open, wantMsg: "SELECT failed"},
+		{name: "auth required", target: secured, wantMsg: "NOAUTH"},
+		{name: "auth with password", checker: RedisChecker{Password: "secret"}, target: secured, wantUp: true},
+		{name: "auth with acl user", checker: RedisChecker{Username: "monitor", Password: "secret"}, target: secured, wantUp: true},
+		{name: "auth wrong password", checker: RedisChecker{Password: "wrong"}, target: secured, wantMsg: "AUTH failed: WRONGPASS"},
+		{name: "auth wrong user", checker: RedisChecker{Username: "admin", Password: "secret"}, target: secured, wantMsg: "AUTH failed"},
+		{name: "invalid expect", checker: RedisChecker{Expect: "("}, target: open, wantMsg: "error parsing regexp"},
+		{name: "empty host", target: "redis://:6379", wantMsg: "empty host"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.checker.Check(context.Background(), tt.target)
+			if tt.wantUp {
+				require.NoError(t, err)
+				assert.Equal(t, "up", res.Status)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, "down", res.Status)
+			assert.Contains(t, res.Message, tt.wantMsg)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package checker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrUnknownProtocol = errors.New("unknown protocol")
+
+// checker 생성 시 전달하는 모니터 설정
+type Config struct {
+	Settings json.RawMessage // Definition.Settings 이름의 설정 블록. 없으면 nil
+	Timeout  time.Duration   // 모니터 체크 제한 시간
+}
+
+// 프로토콜 하나의 이름, 설정 형식, 검증, 생성 방법
+// 외부 checker 도 Register 로 등록하면 모니터 등록, 실행, 프로토콜 목록에 그대로 반영됨
+type Definition struct {
+	Name        string   // 정규 이름 (소문자). 모니터 Type 으로 저장
+	Aliases     []string // 같은 checker 로 처리할 다른 이름
+	Description string
+	Settings    string   // 모니터 설정에서 읽는 블록 이름. 여러 프로토콜이 같은 블록을 공유할 수 있음
+	Schema      any      // 설정 블록 구조체의 zero 값. json 태그로 필드 목록을 만듦
+	Secrets     []string // 인증 정보 필드 경로 ("basic_auth.password"). 객체("headers")면 모든 값. 저장 시 암호화하고 응답에서 가림
+	HostOnly    bool     // 포트 없이 호스트만 쓰는 검사 (dns, icmp). 모니터 등록 시 포트를 받지 않음
+
+	Validate func(settings json.RawMessage) error // nil 이면 검증 없음
+	New      func(cfg Config) (Checker, error)    // nil 이면 서버가 직접 체크하지 않는 모니터 (heartbeat)
+}
+
+// 설정 블록 필드 설명
+type Field struct {
+	Name string
+	Type string // string, integer, number, boolean, array, object
+}
+
+// Schema 의 json 태그를 따라 설정 필드 목록 생성
+func (d Definition) Fields() []Field {
+	if d.Schema == nil {
+		return nil
+	}
+	t := reflect.TypeOf(d.Schema)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	return structFields(t)
+}
+
+func structFields(t reflect.Type) []Field {
+	var fields []Field
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		if f.Anonymous && f.Tag.Get("json") == "" {
+			fields = append(fields, structFields(f.Type)...)
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, Field{Name: name, Type: jsonType(f.Type)})
+	}
+	return fields
+}
+
+func jsonType(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
+type Registry struct {
+	mu    sync.RWMutex
+	defs  []*Definition          // 등록 순서 유지
+	index map[string]*Definition // 소문자 이름과 별칭
+}
+
+func NewRegistry() *Registry {
+	return &Registry{index: make(map[string]*Definition)}
+}
+
+// 이름이나 별칭이 이미 등록되어 있으면 에러
+func (r *Registry) Register(d Definition) error {
+	if d.Name == "" {
+		return errors.New("checker name is required")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	names := append([]string{d.Name}, d.Aliases...)
+	for _, n := range names {
+		if _, ok := r.index[strings.ToLower(n)]; ok {
+			return fmt.Errorf("checker %q is already registered", n)
+		}
+	}
+
+	def := d
+	def.Name = strings.ToLower(d.Name)
+	r.defs = append(r.defs, &def)
+	for _, n := range names {
+		r.index[strings.ToLower(n)] = &def
+	}
+	return nil
+}
+
+func (r *Registry) MustRegister(d Definition) {
+	if err := r.Register(d); err != nil {
+		panic(err)
+	}
+}
+
+// 이름이나 별칭으로 조회. 대소문자 구분 없음
+func (r *Registry) Lookup(name string) (Definition, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	d, ok := r.index[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return Definition{}, false
+	}
+	return *d, true
+}
+
+// 설정 블록 이름으로 조회. 블록을 공유하면 먼저 등록된 정의를 반환
+func (r *Registry) LookupSettings(key string) (Definition, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, d := range r.defs {
+		if d.Settings != "" && d.Settings == key {
+			return *d, true
+		}
+	}
+	return Definition{}, false
+}
+
+func (r *Registry) Definitions() []Definition {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make([]Definition, len(r.defs))
+	for i, d := range r.defs {
+		out[i] = *d
+	}
+	return out
+}
+
+// 이름으로 checker 생성
+func (r *Registry) New(name string, cfg Config) (Checker, error) {
+	d, ok := r.Lookup(name)
+	if !ok || d.New == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProtocol, name)
+	}
+	return d.New(cfg)
+}
+
+var defaultRegistry = NewRegistry()
+
+// 기본 레지스트리에 등록. 외부 checker 는 init 에서 호출
+func Register(d Definition) error { return defaultRegistry.Register(d) }
+
+func MustRegister(d Definition) { defaultRegistry.MustRegister(d) }
+
+func Lookup(name string) (Definition, bool) { return defaultRegistry.Lookup(name) }
+
+func LookupSettings(key string) (Definition, bool) { return defaultRegistry.LookupSettings(key) }
+
+func Definitions() []Definition { return defaultRegistry.Definitions() }
+
+func New(name string, cfg Config) (Checker, error) { return defaultRegistry.New(name, cfg) }
@@ -0,0 +1,91 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type echoChecker struct {
+	Status string `json:"status"`
+}
+
+func (e *echoChecker) Check(context.Context, string) (*CheckResult, error) {
+	return &CheckResult{Status: e.Status}, nil
+}
+
+func echoDefinition() Definition {
+	return Definition{
+		Name:     "Echo",
+		Aliases:  []string{"echo2"},
+		Settings: "echo",
+		Schema:   echoChecker{},
+		Validate: func(raw json.RawMessage) error {
+			var c echoChecker
+			if err := DecodeSettings(raw, &c); err != nil {
+				return err
+			}
+			if c.Status == "" {
+				return errors.New("status is required")
+			}
+			return nil
+		},
+		New: func(cfg Config) (Checker, error) {
+			c := &echoChecker{}
+			return c, DecodeSettings(cfg.Settings, c)
+		},
+	}
+}
+
+func TestRegistry_RegisterAndLookup(t *testing.T) {
+	r := NewRegistry()
+	require.NoError(t, r.Register(echoDefinition()))
+
+	for _, name := range []string{"echo", "ECHO", " Echo2 "} {
+		d, ok := r.Lookup(name)
+		require.True(t, ok, name)
+		assert.Equal(t, "echo", d.Name)
+	}
+	_, ok := r.Lookup("unknown")
+	assert.False(t, ok)
+
+	d, ok := r.LookupSettings("echo")
+	require.True(t, ok)
+	assert.Equal(t, []Field{{Name: "status", Type: "string"}}, d.Fields())
+
+	assert.Error(t, d.Validate(json.RawMessage(`{}`)))
+	assert.Error(t, d.Validate(json.RawMessage(`{"status":"up","other":1}`)))
+	assert.NoError(t, d.Validate(json.RawMessage(`{"status":"up"}`)))
+
+	c, err := r.New("echo2", Config{Settings: json.RawMessage(`{"status":"degraded"}`)})
+	require.NoError(t, err)
+	res, err := c.Check(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, "degraded", res.Status)
+}
+
+func TestRegistry_RejectsDuplicates(t *testing.T) {
+	r := NewRegistry()
+	require.NoError(t, r.Register(echoDefinition()))
+
+	assert.Error(t, r.Register(Definition{Name: "ECHO"}))
+	assert.Error(t, r.Register(Definition{Name: "other", Aliases: []string{"echo2"}}))
+	assert.Error(t, r.Register(Definition{}))
+	assert.Len(t, r.Definitions(), 1)
+}
+
+func TestRegistry_UnknownProtocol(t *testing.T) {
+	_, err := NewRegistry().New("nope", Config{})
+	assert.ErrorIs(t, err, ErrUnknownProtocol)
+}
+
+func TestDefaultRegistry_Builtins(t *testing.T) {
+	for _, name := range []string{"http", "HTTPS", "tcp", "ws", "WebSocket", "tls", "dns", "ping", "udp", "grpc", "postgresql", "redis", "smtp", "imap", "pop3", "ssh", "transaction"} {
+		_, err := New(name, Config{})
+		assert.NoError(t, err, name)
+	}
+}
@@ -0,0 +1,120 @@
+package checker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// 응답에서 인증 정보 대신 보여주는 값. 수정 요청에 그대로 보내면 저장된 값을 유지
+const SecretMask = "********"
+
+// 설정 블록을 v 로 디코딩. 블록이 없으면 v 를 그대로 둠
+// 외부 checker 의 Validate, New 에서도 같은 규칙으로 해석하도록 공개
+func DecodeSettings(raw json.RawMessage, v any) error {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("invalid settings: %w", err)
+	}
+	return nil
+}
+
+// Secrets 에 선언한 필드에만 fn 을 적용한 새 블록. 빈 값은 그대로 둠
+func (d Definition) MapSecrets(raw json.RawMessage, fn func(string) (string, error)) (json.RawMessage, error) {
+	return d.mapSecrets(raw, func(_ string, v string) (string, error) { return fn(v) })
+}
+
+// 인증 정보를 SecretMask 로 가린 블록. 해석할 수 없는 블록은 노출하지 않고 nil
+func (d Definition) MaskSecrets(raw json.RawMessage) json.RawMessage {
+	out, err := d.MapSecrets(raw, func(string) (string, error) { return SecretMask, nil })
+	if err != nil {
+		return nil
+	}
+	return out
+}
+
+// raw 에서 SecretMask 로 남아 있는 인증 정보를 prev 의 같은 필드 값으로 되돌림
+func (d Definition) KeepSecrets(raw, prev json.RawMessage) (json.RawMessage, error) {
+	saved := make(map[string]string)
+	if _, err := d.mapSecrets(prev, func(key, v string) (string, error) {
+		saved[key] = v
+		return v, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return d.mapSecrets(raw, func(key, v string) (string, error) {
+		if v != SecretMask {
+			return v, nil
+		}
+		if old, ok := saved[key]; ok {
+			return old, nil
+		}
+		return "", nil
+	})
+}
+
+// fn 은 필드 경로(객체면 "경로.키")와 값을 받음
+func (d Definition) mapSecrets(raw json.RawMessage, fn func(key, v string) (string, error)) (json.RawMessage, error) {
+	if len(d.Secrets) == 0 || len(raw) == 0 || string(raw) == "null" {
+		return raw, nil
+	}
+
+	var obj map[string]any
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(&obj); err != nil {
+		return nil, fmt.Errorf("invalid settings: %w", err)
+	}
+
+	for _, path := range d.Secrets {
+		if err := mapSecretPath(obj, path, strings.Split(path, "."), fn); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(obj)
+}
+
+// 경로 끝이 문자열이면 그 값에, 객체면 모든 문자열 값에 fn 적용. 경로가 없으면 무시
+func mapSecretPath(obj map[string]any, path string, keys []string, fn func(key, v string) (string, error)) error {
+	v, ok := obj[keys[0]]
+	if !ok {
+		return nil
+	}
+	if len(keys) > 1 {
+		if child, ok := v.(map[string]any); ok {
+			return mapSecretPath(child, path, keys[1:], fn)
+		}
+		return nil
+	}
+
+	switch v := v.(type) {
+	case string:
+		if v == "" {
+			return nil
+		}
+		out, err := fn(path, v)
+		if err != nil {
+			return err
+		}
+		obj[keys[0]] = out
+	case map[string]any:
+		for k, item := range v {
+			s, ok := item.(string)
+			if !ok || s == "" {
+				continue
+			}
+			out, err := fn(path+"."+k, s)
+			if err != nil {
+				return err
+			}
+			v[k] = out
+		}
+	}
+	return nil
+}
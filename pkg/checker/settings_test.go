@@ -0,0 +1,57 @@
+package checker
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func secretDefinition() Definition {
+	return Definition{Name: "secret", Settings: "secret", Secrets: []string{"auth.password", "token", "vars"}}
+}
+
+func TestDefinition_MapSecrets(t *testing.T) {
+	raw := json.RawMessage(`{"auth":{"user":"u","password":"p"},"token":"t","vars":{"a":"1","b":""},"count":3}`)
+
+	out, err := secretDefinition().MapSecrets(raw, func(v string) (string, error) { return strings.ToUpper(v) + "!", nil })
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"auth":{"user":"u","password":"P!"},"token":"T!","vars":{"a":"1!","b":""},"count":3}`, string(out))
+}
+
+func TestDefinition_MaskSecrets(t *testing.T) {
+	d := secretDefinition()
+
+	out := d.MaskSecrets(json.RawMessage(`{"token":"t","other":"x"}`))
+	assert.JSONEq(t, `{"token":"********","other":"x"}`, string(out))
+
+	assert.Nil(t, d.MaskSecrets(json.RawMessage(`not json`)))
+}
+
+func TestDefinition_KeepSecrets(t *testing.T) {
+	d := secretDefinition()
+	prev := json.RawMessage(`{"auth":{"password":"old"},"token":"old-token","vars":{"a":"1"}}`)
+
+	out, err := d.KeepSecrets(json.RawMessage(`{"auth":{"password":"********"},"token":"new","vars":{"a":"********","c":"********"}}`), prev)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"auth":{"password":"old"},"token":"new","vars":{"a":"1","c":""}}`, string(out))
+}
+
+func TestDefinition_NoSecrets(t *testing.T) {
+	raw := json.RawMessage(`{"token":"t"}`)
+	out, err := Definition{Name: "plain"}.MapSecrets(raw, func(string) (string, error) { return "x", nil })
+	require.NoError(t, err)
+	assert.Equal(t, raw, out)
+}
+
+func TestBuiltin_MaskHeaders(t *testing.T) {
+	for _, name := range []string{"http", "https", "ws"} {
+		d, ok := Lookup(name)
+		require.True(t, ok, name)
+
+		out := d.MaskSecrets(json.RawMessage(`{"headers":{"Authorization":"Bearer t","X-API-Key":"k"}}`))
+		assert.JSONEq(t, `{"headers":{"Authorization":"********","X-API-Key":"********"}}`, string(out), name)
+	}
+}
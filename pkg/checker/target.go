@@ -0,0 +1,51 @@
+package checker
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// 모니터 대상("scheme://host:port", "host:port", "host")에서 호스트와 포트를 분리
+// 스킴은 무시하고, 포트가 없으면 defaultPort 를 사용. defaultPort 도 비어 있으면 오류
+func splitTarget(target, defaultPort string) (host, port string, err error) {
+	if strings.Contains(target, "://") {
+		u, err := url.Parse(target)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid target: %w", err)
+		}
+		host, port = u.Hostname(), u.Port()
+	} else if h, p, err := net.SplitHostPort(target); err == nil {
+		host, port = h, p
+	} else {
+		host = strings.TrimSuffix(strings.TrimPrefix(target, "["), "]")
+	}
+
+	if host == "" {
+		return "", "", errors.New("invalid target: empty host")
+	}
+	if port == "" {
+		port = defaultPort
+	}
+	if port == "" {
+		return "", "", fmt.Errorf("invalid target: missing port in %q", target)
+	}
+	return host, port, nil
+}
+
+// 접속에 쓸 "host:port" 주소
+func targetAddr(target, defaultPort string) (string, error) {
+	host, port, err := splitTarget(target, defaultPort)
+	if err != nil {
+		return "", err
+	}
+	return net.JoinHostPort(host, port), nil
+}
+
+// 포트가 필요 없는 검사(DNS, ICMP)에 쓸 호스트
+func targetHost(target string) (string, error) {
+	host, _, err := splitTarget(target, "0")
+	return host, err
+}
@@ -0,0 +1,59 @@
+package checker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTargetAddr(t *testing.T) {
+	tests := []struct {
+		target      string
+		defaultPort string
+		want        string
+	}{
+		{"tcp://example.com:8080", "", "example.com:8080"},
+		{"tls://example.com:8443", "443", "example.com:8443"},
+		{"https://example.com", "443", "example.com:443"},
+		{"example.com", "443", "example.com:443"},
+		{"example.com:6380", "6379", "example.com:6380"},
+		{"[::1]:443", "", "[::1]:443"},
+		{"udp://[::1]:53", "", "[::1]:53"},
+		{"::1", "5432", "[::1]:5432"},
+		{"[::1]", "5432", "[::1]:5432"},
+	}
+	for _, tt := range tests {
+		got, err := targetAddr(tt.target, tt.defaultPort)
+		require.NoError(t, err, tt.target)
+		assert.Equal(t, tt.want, got, tt.target)
+	}
+}
+
+func TestTargetAddr_Invalid(t *testing.T) {
+	for _, target := range []string{
+		"",
+		"tcp://",
+		"tcp://:8080",
+		"example.com",
+		"tcp://example.com",
+		"tcp://exa mple.com:80",
+	} {
+		_, err := targetAddr(target, "")
+		assert.Error(t, err, target)
+	}
+}
+
+func TestTargetHost(t *testing.T) {
+	for target, want := range map[string]string{
+		"dns://example.com:53": "example.com",
+		"icmp://10.0.0.1":      "10.0.0.1",
+		"example.com":          "example.com",
+		"example.com:80":       "example.com",
+		"[2001:db8::1]:80":     "2001:db8::1",
+	} {
+		got, err := targetHost(target)
+		require.NoError(t, err, target)
+		assert.Equal(t, want, got, target)
+	}
+}
@@ -10,15 +10,20 @@ import (
 type TCPChecker struct{}
 
 func (t *TCPChecker) Check(ctx context.Context, target string) (*CheckResult, error) {
+	addr, err := targetAddr(target, "")
+	if err != nil {
+		return nil, err
+	}
+
 	start := time.Now()
 
 	dialer := net.Dialer{}
-	conn, err := dialer.DialContext(ctx, "tcp", target)
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
 	if err != nil {
 		return nil, fmt.Errorf("tcp connection failed: %w", err)
 	}
 	elapsed := time.Since(start).Milliseconds()
 
 	defer conn.Close()
-	return &CheckResult{"up", "", int(elapsed)}, nil
+	return &CheckResult{Status: "up", ResponseMs: int(elapsed)}, nil
 }
@@ -0,0 +1,148 @@
+package checker
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// 응답 시간의 구간별 소요 시간. 연결 재사용이나 IP 직접 지정처럼 구간이 없으면 0
+type Timing struct {
+	DNSMs       float64 // 이름 조회
+	ConnectMs   float64 // TCP 연결
+	TLSMs       float64 // TLS 핸드셰이크
+	FirstByteMs float64 // 요청 전송 완료부터 응답 첫 바이트까지 (서버 처리 시간)
+	TransferMs  float64 // 첫 바이트부터 본문을 다 읽을 때까지
+	RemoteIP    string  // 실제로 연결한 주소
+	StatusCode  int     // HTTP 응답 코드
+	SizeBytes   int64   // 읽은 본문 크기
+}
+
+// httptrace 로 요청 구간을 기록. 리다이렉트를 따라가면 마지막 요청 기준
+type httpTrace struct {
+	mu        sync.Mutex
+	timing    Timing
+	dnsStart  time.Time
+	connStart time.Time
+	tlsStart  time.Time
+	wrote     time.Time
+	firstByte time.Time
+}
+
+func (h *httpTrace) clientTrace() *httptrace.ClientTrace {
+	record := func(fn func(now time.Time)) {
+		now := time.Now()
+		h.mu.Lock()
+		fn(now)
+		h.mu.Unlock()
+	}
+
+	return &httptrace.ClientTrace{
+		GetConn: func(string) {
+			record(func(time.Time) {
+				h.timing = Timing{}
+			})
+		},
+		DNSStart: func(httptrace.DNSStartInfo) {
+			record(func(now time.Time) { h.dnsStart = now })
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			record(func(now time.Time) { h.timing.DNSMs = durationMs(now.Sub(h.dnsStart)) })
+		},
+		ConnectStart: func(string, string) {
+			record(func(now time.Time) { h.connStart = now })
+		},
+		ConnectDone: func(_, _ string, err error) {
+			if err != nil {
+				return
+			}
+			record(func(now time.Time) { h.timing.ConnectMs = durationMs(now.Sub(h.connStart)) })
+		},
+		TLSHandshakeStart: func() {
+			record(func(now time.Time) { h.tlsStart = now })
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			record(func(now time.Time) { h.timing.TLSMs = durationMs(now.Sub(h.tlsStart)) })
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			record(func(time.Time) { h.timing.RemoteIP = remoteIP(info.Conn.RemoteAddr()) })
+		},
+		WroteRequest: func(httptrace.WroteRequestInfo) {
+			record(func(now time.Time) { h.wrote = now })
+		},
+		GotFirstResponseByte: func() {
+			record(func(now time.Time) {
+				h.firstByte = now
+				h.timing.FirstByteMs = durationMs(now.Sub(h.wrote))
+			})
+		},
+	}
+}
+
+// 본문을 다 읽은 뒤 호출해서 전송 시간과 크기를 채운 결과를 반환
+func (h *httpTrace) finish(statusCode int, size int64) *Timing {
+	now := time.Now()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	t := h.timing
+	if !h.firstByte.IsZero() {
+		t.TransferMs = durationMs(now.Sub(h.firstByte))
+	}
+	t.StatusCode = statusCode
+	t.SizeBytes = size
+	return &t
+}
+
+// 이름 조회와 TCP 연결을 나눠서 재며 연결. 조회된 주소를 차례로 시도
+func dialTimed(ctx context.Context, addr string, t *Timing) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	var ips []string
+	if ip := net.ParseIP(host); ip != nil {
+		ips = []string{host}
+	} else {
+		start := time.Now()
+		addrs, err := net.DefaultResolver.LookupHost(ctx, host)
+		t.DNSMs = durationMs(time.Since(start))
+		if err != nil {
+			return nil, err
+		}
+		ips = addrs
+	}
+
+	var dialer net.Dialer
+	start := time.Now()
+	var lastErr error
+	for _, ip := range ips {
+		conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(ip, port))
+		if err == nil {
+			t.ConnectMs = durationMs(time.Since(start))
+			t.RemoteIP = ip
+			return conn, nil
+		}
+		lastErr = err
+	}
+	if lastErr == nil {
+		lastErr = errors.New("no addresses found")
+	}
+	return nil, lastErr
+}
+
+func remoteIP(addr net.Addr) string {
+	if addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return addr.String()
+	}
+	return host
+}
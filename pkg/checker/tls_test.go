@@ -0,0 +1,116 @@
+package checker
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 테스트용 CA 로 서명한 인증서를 제공하는 TLS 서버를 띄우고 주소와 루트 풀을 반환
+func startTLSServer(t *testing.T, notAfter time.Time, dnsNames ...string) (string, *x509.CertPool) {
+	t.Helper()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "keeplo test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(365 * 24 * time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTmpl, caTmpl, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	leafTmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: dnsNames[0]},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTmpl, caCert, &leafKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{leafDER}, PrivateKey: leafKey}},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.(*tls.Conn).Handshake()
+			conn.Close()
+		}
+	}()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(caCert)
+	return ln.Addr().String(), pool
+}
+
+func TestTLSChecker_Valid(t *testing.T) {
+	addr, pool := startTLSServer(t, time.Now().Add(90*24*time.Hour), "keeplo.test")
+	c := &TLSChecker{ServerName: "keeplo.test", RootCAs: pool}
+
+	result, err := c.Check(context.Background(), "tls://"+addr)
+	require.NoError(t, err)
+	assert.Equal(t, "up", result.Status)
+	assert.Contains(t, result.Message, "expires in 89 days")
+
+	require.NotNil(t, result.Timing)
+	assert.Equal(t, "127.0.0.1", result.Timing.RemoteIP)
+	assert.Greater(t, result.Timing.TLSMs, 0.0)
+}
+
+func TestTLSChecker_ExpiringSoon(t *testing.T) {
+	addr, pool := startTLSServer(t, time.Now().Add(10*24*time.Hour), "keeplo.test")
+
+	result, err := (&TLSChecker{ServerName: "keeplo.test", RootCAs: pool}).Check(context.Background(), addr)
+	require.NoError(t, err)
+	assert.Equal(t, "degraded", result.Status)
+
+	result, err = (&TLSChecker{ServerName: "keeplo.test", RootCAs: pool, CriticalDays: 11}).Check(context.Background(), addr)
+	assert.Error(t, err)
+	assert.Equal(t, "down", result.Status)
+}
+
+func TestTLSChecker_HostnameMismatch(t *testing.T) {
+	addr, pool := startTLSServer(t, time.Now().Add(90*24*time.Hour), "other.test")
+
+	result, err := (&TLSChecker{ServerName: "keeplo.test", RootCAs: pool}).Check(context.Background(), addr)
+	assert.Error(t, err)
+	assert.Equal(t, "down", result.Status)
+	assert.Contains(t, result.Message, "certificate verification failed")
+}
+
+func TestTLSChecker_UnknownAuthority(t *testing.T) {
+	addr, _ := startTLSServer(t, time.Now().Add(90*24*time.Hour), "keeplo.test")
+
+	result, err := (&TLSChecker{ServerName: "keeplo.test", RootCAs: x509.NewCertPool()}).Check(context.Background(), addr)
+	assert.Error(t, err)
+	assert.Equal(t, "down", result.Status)
+}
@@ -0,0 +1,311 @@
+package checker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	ExtractJSONPath = "json_path" // 본문 JSON 의 Target($.token 형식) 값
+	ExtractHeader   = "header"    // Target 헤더 값
+	ExtractRegex    = "regex"     // 본문에서 정규식 Target 의 첫 번째 그룹 (그룹이 없으면 전체 일치)
+)
+
+const maxTransactionSteps = 20
+
+var (
+	templateVarPattern = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+	varNamePattern     = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// 순서대로 실행하는 HTTP 요청 묶음. 앞 단계 응답에서 뽑은 값을 {{name}} 으로 다음 단계에 넣음
+// 쿠키는 단계 사이에 유지되어 로그인 흐름을 그대로 따라갈 수 있음
+type TransactionChecker struct {
+	Steps     []TransactionStep
+	Variables map[string]string // 초기 변수
+	Timeout   time.Duration     // 단계별 요청 제한 시간. 0 이면 defaultTimeout
+	TLS       bool              // 대상 주소가 http(s) 가 아닐 때 (transaction://host:port) https 로 접속
+}
+
+type TransactionStep struct {
+	Name                string            `json:"name,omitempty"`                  // 결과에 표시할 이름. 비어 있으면 "step N"
+	Method              string            `json:"method,omitempty"`                // 기본 GET
+	URL                 string            `json:"url"`                             // 절대 URL 또는 대상 주소 기준 경로. 템플릿 가능
+	Headers             map[string]string `json:"headers,omitempty"`               // 값에 템플릿 가능
+	Body                string            `json:"body,omitempty"`                  // 템플릿 가능
+	AcceptedStatusCodes []string          `json:"accepted_status_codes,omitempty"` // 비어 있으면 400 미만
+	Assertions          []Assertion       `json:"assertions,omitempty"`            //
+	Extract             []Extraction      `json:"extract,omitempty"`               // 검사를 통과한 뒤 변수로 저장
+}
+
+type Extraction struct {
+	Var    string `json:"var"`    // 저장할 변수 이름
+	Type   string `json:"type"`   // json_path, header, regex
+	Target string `json:"target"` // JSON 경로, 헤더 이름 또는 정규식
+}
+
+// 단계별 실행 결과. 실패한 단계 이후는 실행하지 않으므로 포함되지 않음
+type StepResult struct {
+	Name       string
+	Status     string // "up" or "down"
+	StatusCode int
+	ResponseMs int
+	Message    string
+}
+
+// 단계 구성, 검사 조건, 변수 참조가 올바른지 확인
+// 템플릿은 초기 변수나 앞 단계에서 뽑은 변수만 참조할 수 있음
+func (t *TransactionChecker) Validate() error {
+	if len(t.Steps) == 0 {
+		return errors.New("transaction requires at least one step")
+	}
+	if len(t.Steps) > maxTransactionSteps {
+		return fmt.Errorf("transaction allows at most %d steps", maxTransactionSteps)
+	}
+
+	defined := make(map[string]bool, len(t.Variables))
+	for name := range t.Variables {
+		if !varNamePattern.MatchString(name) {
+			return fmt.Errorf("invalid variable name: %q", name)
+		}
+		defined[name] = true
+	}
+
+	for i, step := range t.Steps {
+		name := stepName(step, i)
+		if step.URL == "" {
+			return fmt.Errorf("%s: url is required", name)
+		}
+		if err := validateMethod(step.Method); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		if _, err := ParseStatusRanges(step.AcceptedStatusCodes); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		if err := ValidateAssertions(step.Assertions); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		templates := []string{step.URL, step.Body}
+		for _, v := range step.Headers {
+			templates = append(templates, v)
+		}
+		for _, tmpl := range templates {
+			for _, m := range templateVarPattern.FindAllStringSubmatch(tmpl, -1) {
+				if !defined[m[1]] {
+					return fmt.Errorf("%s: undefined variable %q", name, m[1])
+				}
+			}
+		}
+
+		for _, e := range step.Extract {
+			if err := validateExtraction(e); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+			defined[e.Var] = true
+		}
+	}
+	return nil
+}
+
+func (t *TransactionChecker) Check(ctx context.Context, target string) (*CheckResult, error) {
+	if err := t.Validate(); err != nil {
+		return &CheckResult{Status: "down", Message: err.Error()}, err
+	}
+
+	base, err := url.Parse(target)
+	if err != nil {
+		return &CheckResult{Status: "down", Message: "invalid target"}, err
+	}
+	if base.Scheme != "http" && base.Scheme != "https" {
+		base.Scheme = "http"
+		if t.TLS {
+			base.Scheme = "https"
+		}
+	}
+
+	jar, _ := cookiejar.New(nil)
+	timeout := t.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	// 단계 사이에는 연결을 재사용하되 다음 검사로 넘기지 않음
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	defer transport.CloseIdleConnections()
+	client := &http.Client{Timeout: timeout, Jar: jar, Transport: transport}
+
+	vars := make(map[string]string, len(t.Variables))
+	for k, v := range t.Variables {
+		vars[k] = v
+	}
+
+	result := &CheckResult{Status: "up"}
+	for i, step := range t.Steps {
+		sr, err := runStep(ctx, client, base, step, vars)
+		sr.Name = stepName(step, i)
+		result.Steps = append(result.Steps, *sr)
+		result.ResponseMs += sr.ResponseMs
+
+		if err != nil {
+			result.Status = "down"
+			result.Message = fmt.Sprintf("step %d (%s) failed: %v; %s", i+1, sr.Name, err, stepTimings(result.Steps))
+			return result, errors.New(result.Message)
+		}
+	}
+
+	result.Message = stepTimings(result.Steps)
+	return result, nil
+}
+
+// 요청을 보내고 상태 코드, 검사 조건, 변수 추출을 차례로 처리
+func runStep(ctx context.Context, client *http.Client, base *url.URL, step TransactionStep, vars map[string]string) (*StepResult, error) {
+	sr := &StepResult{Status: "down"}
+
+	ref, err := url.Parse(renderTemplate(step.URL, vars))
+	if err != nil {
+		sr.Message = fmt.Sprintf("invalid url: %v", err)
+		return sr, errors.New(sr.Message)
+	}
+
+	method := step.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	var body io.Reader
+	if step.Body != "" {
+		body = strings.NewReader(renderTemplate(step.Body, vars))
+	}
+
+	start := time.Now()
+	req, err := http.NewRequestWithContext(ctx, method, base.ResolveReference(ref).String(), body)
+	if err != nil {
+		sr.Message = err.Error()
+		return sr, err
+	}
+	for k, v := range step.Headers {
+		req.Header.Set(k, renderTemplate(v, vars))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		sr.ResponseMs = int(time.Since(start).Milliseconds())
+		sr.Message = err.Error()
+		return sr, err
+	}
+	defer resp.Body.Close()
+
+	// 추출과 검사에 쓰도록 본문을 끝까지 읽은 시점까지를 단계 시간으로 기록
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxAssertBodyBytes))
+	sr.ResponseMs = int(time.Since(start).Milliseconds())
+	sr.StatusCode = resp.StatusCode
+	if err != nil {
+		sr.Message = err.Error()
+		return sr, err
+	}
+
+	accepted, _ := ParseStatusRanges(step.AcceptedStatusCodes)
+	if !statusAccepted(accepted, resp.StatusCode) {
+		sr.Message = fmt.Sprintf("HTTP status %d", resp.StatusCode)
+		return sr, errors.New(sr.Message)
+	}
+	if err := checkAssertions(step.Assertions, resp.Header, respBody); err != nil {
+		sr.Message = err.Error()
+		return sr, err
+	}
+	for _, e := range step.Extract {
+		v, err := extractValue(e, resp.Header, respBody)
+		if err != nil {
+			sr.Message = fmt.Sprintf("extract %s: %v", e.Var, err)
+			return sr, errors.New(sr.Message)
+		}
+		vars[e.Var] = v
+	}
+
+	sr.Status = "up"
+	return sr, nil
+}
+
+func validateExtraction(e Extraction) error {
+	if !varNamePattern.MatchString(e.Var) {
+		return fmt.Errorf("invalid variable name: %q", e.Var)
+	}
+	switch e.Type {
+	case ExtractJSONPath:
+		_, err := parseJSONPath(e.Target)
+		return err
+	case ExtractHeader:
+		if e.Target == "" {
+			return errors.New("header extraction requires a header name")
+		}
+	case ExtractRegex:
+		if _, err := regexp.Compile(e.Target); err != nil {
+			return fmt.Errorf("invalid regex %q: %w", e.Target, err)
+		}
+	default:
+		return fmt.Errorf("unknown extraction type: %q", e.Type)
+	}
+	return nil
+}
+
+func extractValue(e Extraction, header http.Header, body []byte) (string, error) {
+	switch e.Type {
+	case ExtractJSONPath:
+		return lookupJSONPath(body, e.Target)
+	case ExtractHeader:
+		v := header.Get(e.Target)
+		if v == "" {
+			return "", fmt.Errorf("header %s not found", e.Target)
+		}
+		return v, nil
+	case ExtractRegex:
+		re, err := regexp.Compile(e.Target)
+		if err != nil {
+			return "", err
+		}
+		m := re.FindSubmatch(body)
+		if m == nil {
+			return "", fmt.Errorf("body does not match /%s/", e.Target)
+		}
+		if len(m) > 1 {
+			return string(m[1]), nil
+		}
+		return string(m[0]), nil
+	default:
+		return "", fmt.Errorf("unknown extraction type: %q", e.Type)
+	}
+}
+
+// {{name}} 을 변수 값으로 치환. 검증을 거친 뒤라 모르는 변수는 그대로 둠
+func renderTemplate(s string, vars map[string]string) string {
+	return templateVarPattern.ReplaceAllStringFunc(s, func(m string) string {
+		name := templateVarPattern.FindStringSubmatch(m)[1]
+		if v, ok := vars[name]; ok {
+			return v
+		}
+		return m
+	})
+}
+
+func stepName(step TransactionStep, i int) string {
+	if step.Name != "" {
+		return step.Name
+	}
+	return fmt.Sprintf("step %d", i+1)
+}
+
+// "login 120ms, profile 35ms"
+func stepTimings(steps []StepResult) string {
+	parts := make([]string, len(steps))
+	for i, s := range steps {
+		parts[i] = fmt.Sprintf("%s %dms", s.Name, s.ResponseMs)
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,131 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 로그인 -> 토큰 -> 프로필 조회 흐름을 흉내내는 테스트 서버
+func startLoginServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /login", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			User     string `json:"user"`
+			Password string `json:"password"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "s-1"})
+		w.Header().Set("X-Request-Id", "req-42")
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]string{"token": "tok-" + req.User}})
+	})
+	mux.HandleFunc("GET /users/{name}", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer tok-"+r.PathValue("name") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if c, err := r.Cookie("session"); err != nil || c.Value != "s-1" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = w.Write([]byte(`<p>hello ` + r.PathValue("name") + `, trace ` + r.Header.Get("X-Trace") + `</p>`))
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func loginTransaction(password string) *TransactionChecker {
+	return &TransactionChecker{
+		Variables: map[string]string{"user": "alice"},
+		Steps: []TransactionStep{
+			{
+				Name:    "login",
+				Method:  http.MethodPost,
+				URL:     "/login",
+				Headers: map[string]string{"Content-Type": "application/json"},
+				Body:    `{"user":"{{user}}","password":"` + password + `"}`,
+				Extract: []Extraction{
+					{Var: "token", Type: ExtractJSONPath, Target: "$.data.token"},
+					{Var: "request_id", Type: ExtractHeader, Target: "X-Request-Id"},
+				},
+			},
+			{
+				Name: "profile",
+				URL:  "/users/{{ user }}",
+				Headers: map[string]string{
+					"Authorization": "Bearer {{token}}",
+					"X-Trace":       "{{request_id}}",
+				},
+				Assertions: []Assertion{{Type: AssertContains, Value: "hello alice"}},
+				Extract:    []Extraction{{Var: "trace", Type: ExtractRegex, Target: `trace (\S+)</p>`}},
+			},
+		},
+	}
+}
+
+func TestTransactionChecker_Success(t *testing.T) {
+	srv := startLoginServer(t)
+
+	res, err := loginTransaction("secret").Check(context.Background(), srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, "up", res.Status)
+	require.Len(t, res.Steps, 2)
+	assert.Equal(t, "login", res.Steps[0].Name)
+	assert.Equal(t, http.StatusOK, res.Steps[1].StatusCode)
+	assert.Contains(t, res.Message, "login ")
+	assert.Contains(t, res.Message, "profile ")
+}
+
+func TestTransactionChecker_ReportsFailedStep(t *testing.T) {
+	srv := startLoginServer(t)
+
+	res, err := loginTransaction("wrong").Check(context.Background(), srv.URL)
+	require.Error(t, err)
+	assert.Equal(t, "down", res.Status)
+	require.Len(t, res.Steps, 1)
+	assert.Equal(t, http.StatusUnauthorized, res.Steps[0].StatusCode)
+	assert.Contains(t, res.Message, "step 1 (login) failed: HTTP status 401")
+}
+
+func TestTransactionChecker_ExtractionFailure(t *testing.T) {
+	srv := startLoginServer(t)
+
+	tx := loginTransaction("secret")
+	tx.Steps[0].Extract[0].Target = "$.data.missing"
+
+	res, err := tx.Check(context.Background(), srv.URL)
+	require.Error(t, err)
+	assert.Contains(t, res.Message, "extract token")
+}
+
+func TestTransactionChecker_Validate(t *testing.T) {
+	cases := map[string]*TransactionChecker{
+		"no steps":           {},
+		"undefined variable": {Steps: []TransactionStep{{URL: "/a?t={{token}}"}}},
+		"used before extract": {Steps: []TransactionStep{
+			{URL: "/a", Headers: map[string]string{"X": "{{id}}"}},
+			{URL: "/b", Extract: []Extraction{{Var: "id", Type: ExtractHeader, Target: "X-Id"}}},
+		}},
+		"bad extraction": {Steps: []TransactionStep{{URL: "/a", Extract: []Extraction{{Var: "x", Type: "xpath"}}}}},
+		"bad regex":      {Steps: []TransactionStep{{URL: "/a", Extract: []Extraction{{Var: "x", Type: ExtractRegex, Target: "("}}}}},
+	}
+	for name, tx := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, tx.Validate())
+		})
+	}
+
+	assert.NoError(t, loginTransaction("secret").Validate())
+}
@@ -0,0 +1,125 @@
+package checker
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const maxUDPReplyBytes = 64 * 1024
+
+// 페이로드를 보내고 타임아웃 안에 응답이 오는지 확인
+// Expect 가 있으면 응답이 정규식과 일치해야 정상
+type UDPChecker struct {
+	Payload    string        `json:"payload,omitempty"`     // 보낼 데이터
+	PayloadHex bool          `json:"payload_hex,omitempty"` // true 면 Payload 를 hex 문자열로 해석 ("fffffffe54534f55...")
+	Expect     string        `json:"expect,omitempty"`      // 응답 정규식. 비어 있으면 응답이 오기만 하면 정상
+	Timeout    time.Duration `json:"-"`
+}
+
+// 페이로드와 응답 패턴 형식 검증
+func (u *UDPChecker) Validate() error {
+	if _, err := u.payload(); err != nil {
+		return err
+	}
+	if _, err := u.expect(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (u *UDPChecker) Check(ctx context.Context, target string) (*CheckResult, error) {
+	payload, err := u.payload()
+	if err != nil {
+		return &CheckResult{Status: "down", Message: err.Error()}, err
+	}
+
+	expect, err := u.expect()
+	if err != nil {
+		return &CheckResult{Status: "down", Message: err.Error()}, err
+	}
+
+	addr, err := targetAddr(target, "")
+	if err != nil {
+		return &CheckResult{Status: "down", Message: err.Error()}, err
+	}
+
+	start := time.Now()
+	dialer := net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "udp", addr)
+	if err != nil {
+		msg := fmt.Sprintf("udp dial failed: %v", err)
+		return &CheckResult{Status: "down", Message: msg}, errors.New(msg)
+	}
+	defer conn.Close()
+
+	timeout := u.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	deadline := time.Now().Add(timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	_ = conn.SetDeadline(deadline)
+
+	// 컨텍스트가 취소되면 대기 중인 Read 를 즉시 깨움
+	stop := context.AfterFunc(ctx, func() { _ = conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	if _, err := conn.Write(payload); err != nil {
+		msg := fmt.Sprintf("udp write failed: %v", err)
+		return &CheckResult{Status: "down", Message: msg}, errors.New(msg)
+	}
+
+	buf := make([]byte, maxUDPReplyBytes)
+	n, err := conn.Read(buf)
+	elapsed := int(time.Since(start).Milliseconds())
+	if err != nil {
+		msg := fmt.Sprintf("no udp reply: %v", err)
+		return &CheckResult{Status: "down", Message: msg, ResponseMs: elapsed}, errors.New(msg)
+	}
+
+	reply := buf[:n]
+	if expect != nil && !expect.Match(reply) {
+		msg := fmt.Sprintf("reply does not match /%s/: %q", u.Expect, truncate(reply, 64))
+		return &CheckResult{Status: "down", Message: msg, ResponseMs: elapsed}, errors.New(msg)
+	}
+	return &CheckResult{Status: "up", ResponseMs: elapsed}, nil
+}
+
+func (u *UDPChecker) payload() ([]byte, error) {
+	if !u.PayloadHex {
+		return []byte(u.Payload), nil
+	}
+
+	cleaned := strings.NewReplacer(" ", "", "\n", "", ":", "").Replace(u.Payload)
+	b, err := hex.DecodeString(strings.TrimPrefix(cleaned, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex payload: %w", err)
+	}
+	return b, nil
+}
+
+func (u *UDPChecker) expect() (*regexp.Regexp, error) {
+	if u.Expect == "" {
+		return nil, nil
+	}
+	re, err := regexp.Compile(u.Expect)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expect pattern: %w", err)
+	}
+	return re, nil
+}
+
+func truncate(b []byte, n int) []byte {
+	if len(b) > n {
+		return b[:n]
+	}
+	return b
+}
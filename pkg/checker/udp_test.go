@@ -0,0 +1,116 @@
+package checker
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 받은 데이터 앞에 "echo:" 를 붙여 돌려주는 UDP 서버. ping 으로 시작하지 않으면 응답하지 않음
+func startUDPEchoServer(t *testing.T) string {
+	t.Helper()
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { pc.Close() })
+
+	go func() {
+		buf := make([]byte, 1024)
+		for {
+			n, addr, err := pc.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if !bytes.HasPrefix(buf[:n], []byte("ping")) {
+				continue
+			}
+			_, _ = pc.WriteTo(append([]byte("echo:"), buf[:n]...), addr)
+		}
+	}()
+
+	return pc.LocalAddr().String()
+}
+
+func TestUDPChecker_Payload(t *testing.T) {
+	tests := []struct {
+		name    string
+		checker UDPChecker
+		want    []byte
+		wantErr bool
+	}{
+		{name: "text", checker: UDPChecker{Payload: "ping"}, want: []byte("ping")},
+		{name: "empty", checker: UDPChecker{}, want: []byte{}},
+		{name: "hex", checker: UDPChecker{Payload: "fffffffe", PayloadHex: true}, want: []byte{0xff, 0xff, 0xff, 0xfe}},
+		{name: "hex with prefix", checker: UDPChecker{Payload: "0x7069", PayloadHex: true}, want: []byte("pi")},
+		{name: "hex with separators", checker: UDPChecker{Payload: "70:69 6e\n67", PayloadHex: true}, want: []byte("ping")},
+		{name: "odd hex", checker: UDPChecker{Payload: "fff", PayloadHex: true}, wantErr: true},
+		{name: "invalid hex", checker: UDPChecker{Payload: "zz", PayloadHex: true}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.checker.payload()
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Error(t, tt.checker.Validate())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUDPChecker_Validate(t *testing.T) {
+	assert.NoError(t, (&UDPChecker{Expect: "^echo:"}).Validate())
+	assert.Error(t, (&UDPChecker{Expect: "("}).Validate())
+}
+
+func TestUDPChecker(t *testing.T) {
+	addr := startUDPEchoServer(t)
+
+	tests := []struct {
+		name    string
+		checker UDPChecker
+		target  string
+		wantUp  bool
+		wantMsg string
+	}{
+		{name: "reply", checker: UDPChecker{Payload: "ping"}, target: addr, wantUp: true},
+		{name: "reply with scheme", checker: UDPChecker{Payload: "ping"}, target: "udp://" + addr, wantUp: true},
+		{name: "hex payload", checker: UDPChecker{Payload: "70696e67", PayloadHex: true, Expect: "^echo:ping$"}, target: addr, wantUp: true},
+		{name: "expect mismatch", checker: UDPChecker{Payload: "ping", Expect: "^pong"}, target: addr, wantMsg: "does not match"},
+		{name: "no reply", checker: UDPChecker{Payload: "hello", Timeout: 200 * time.Millisecond}, target: addr, wantMsg: "no udp reply"},
+		{name: "missing port", checker: UDPChecker{Payload: "ping"}, target: "127.0.0.1", wantMsg: "missing port"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.checker.Check(context.Background(), tt.target)
+			if tt.wantUp {
+				require.NoError(t, err)
+				assert.Equal(t, "up", res.Status)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, "down", res.Status)
+			assert.Contains(t, res.Message, tt.wantMsg)
+		})
+	}
+}
+
+func TestUDPChecker_ContextCanceled(t *testing.T) {
+	addr := startUDPEchoServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	res, err := (&UDPChecker{Payload: "hello"}).Check(ctx, addr)
+	require.Error(t, err)
+	assert.Equal(t, "down", res.Status)
+	assert.Less(t, time.Since(start), 2*time.Second)
+}
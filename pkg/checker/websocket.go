@@ -2,56 +2,133 @@ package checker
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/gorilla/websocket"
 )
 
-type WSChecker struct{}
+// 연결 후 Message 를 보내고 Expect 와 일치하는 응답을 기다림
+// Message, Expect 가 모두 비어 있으면 핸드셰이크 성공만 확인
+type WSChecker struct {
+	Message            string            `json:"message,omitempty"`              // 연결 후 보낼 텍스트 메시지
+	Expect             string            `json:"expect,omitempty"`               // 응답 정규식. 일치하는 메시지가 올 때까지 읽음
+	Subprotocols       []string          `json:"subprotocols,omitempty"`         //
+	Headers            map[string]string `json:"headers,omitempty"`              // 핸드셰이크 요청 헤더. 인증 정보로 취급
+	TLS                bool              `json:"tls,omitempty"`                  // wss:// 로 연결
+	InsecureSkipVerify bool              `json:"insecure_skip_verify,omitempty"` // TLS 사용 시 인증서 검증 생략
+	RootCAs            *x509.CertPool    `json:"-"`                              // nil 이면 시스템 루트 인증서
+}
 
 func (w *WSChecker) Check(ctx context.Context, target string) (*CheckResult, error) {
-	start := time.Now()
+	var expect *regexp.Regexp
+	if w.Expect != "" {
+		re, err := regexp.Compile(w.Expect)
+		if err != nil {
+			return &CheckResult{Status: "down", Message: err.Error()}, err
+		}
+		expect = re
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultTimeout)
+		defer cancel()
+	}
+
+	header := http.Header{}
+	for k, v := range w.Headers {
+		header.Set(k, v)
+	}
 
 	dialer := websocket.Dialer{
-		HandshakeTimeout: defaultTimeout,
+		Proxy:           http.ProxyFromEnvironment,
+		Subprotocols:    w.Subprotocols,
+		TLSClientConfig: &tls.Config{RootCAs: w.RootCAs, InsecureSkipVerify: w.InsecureSkipVerify},
 	}
 
-	done := make(chan error, 1)
-	go func() {
-		conn, _, err := dialer.Dial(target, nil)
-		if err != nil {
-			done <- fmt.Errorf("websocket dial failed: %w", err)
-			return
+	start := time.Now()
+	conn, resp, err := dialer.DialContext(ctx, wsURL(target, w.TLS), header)
+	if err != nil {
+		msg := fmt.Sprintf("websocket dial failed: %v", err)
+		if resp != nil {
+			msg = fmt.Sprintf("websocket dial failed: HTTP status %d", resp.StatusCode)
+		}
+		return &CheckResult{Status: "down", Message: msg, ResponseMs: int(time.Since(start).Milliseconds())}, errors.New(msg)
+	}
+	defer conn.Close()
+
+	if len(w.Subprotocols) > 0 && conn.Subprotocol() == "" {
+		msg := "server did not accept any subprotocol"
+		return &CheckResult{Status: "down", Message: msg, ResponseMs: int(time.Since(start).Milliseconds())}, errors.New(msg)
+	}
+
+	if err := w.exchange(ctx, conn, expect); err != nil {
+		msg := err.Error()
+		return &CheckResult{Status: "down", Message: msg, ResponseMs: int(time.Since(start).Milliseconds())}, err
+	}
+
+	_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+	return &CheckResult{Status: "up", ResponseMs: int(time.Since(start).Milliseconds())}, nil
+}
+
+func (w *WSChecker) exchange(ctx context.Context, conn *websocket.Conn, expect *regexp.Regexp) error {
+	if w.Message == "" && expect == nil {
+		return nil
+	}
+
+	deadline, _ := ctx.Deadline()
+	_ = conn.SetWriteDeadline(deadline)
+	_ = conn.SetReadDeadline(deadline)
+
+	// 컨텍스트가 취소되면 대기 중인 읽기를 즉시 깨움
+	stop := context.AfterFunc(ctx, func() { _ = conn.SetReadDeadline(time.Now()) })
+	defer stop()
+
+	if w.Message != "" {
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(w.Message)); err != nil {
+			return fmt.Errorf("websocket write failed: %w", err)
 		}
-		conn.Close()
-		done <- nil
-	}()
-
-	select {
-	case <-ctx.Done():
-		elapsed := time.Since(start).Milliseconds()
-		return &CheckResult{
-			Status:     "down",
-			Message:    "websocket check timeout",
-			ResponseMs: int(elapsed),
-		}, ctx.Err()
-
-	case err := <-done:
-		elapsed := time.Since(start).Milliseconds()
+	}
 
+	var last []byte
+	for {
+		_, data, err := conn.ReadMessage()
 		if err != nil {
-			return &CheckResult{
-				Status:     "down",
-				Message:    err.Error(),
-				ResponseMs: int(elapsed),
-			}, err
+			if last != nil {
+				return fmt.Errorf("no reply matched /%s/ (last: %q)", w.Expect, truncate(last, 64))
+			}
+			return fmt.Errorf("websocket read failed: %w", err)
 		}
+		if expect == nil || expect.Match(data) {
+			return nil
+		}
+		last = data
+	}
+}
 
-		return &CheckResult{
-			Status:     "up",
-			Message:    "",
-			ResponseMs: int(elapsed),
-		}, nil
+// 모니터 대상은 websocket://, http(s):// 형식일 수 있으므로 ws(s):// 로 변환
+// useTLS 면 평문 스킴도 wss:// 로 연결
+func wsURL(target string, useTLS bool) string {
+	plain := "ws://"
+	if useTLS {
+		plain = "wss://"
+	}
+	for from, to := range map[string]string{
+		"websocket://": plain,
+		"ws://":        plain,
+		"http://":      plain,
+		"https://":     "wss://",
+	} {
+		if strings.HasPrefix(target, from) {
+			return to + strings.TrimPrefix(target, from)
+		}
 	}
+	return target
 }
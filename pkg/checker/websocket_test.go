@@ -0,0 +1,164 @@
+package checker
+
+import (
+	"context"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 연결 직후 "hello" 를 보내고, 받은 메시지를 "echo:" 를 붙여 돌려주는 WebSocket 핸들러
+// X-Token 헤더가 secret 이 아니면 핸드셰이크를 401 로 거부
+func wsHandler() http.Handler {
+	upgrader := websocket.Upgrader{Subprotocols: []string{"keeplo.v1"}}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Token") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		if err := conn.WriteMessage(websocket.TextMessage, []byte("hello")); err != nil {
+			return
+		}
+		for {
+			typ, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if err := conn.WriteMessage(typ, append([]byte("echo:"), data...)); err != nil {
+				return
+			}
+		}
+	})
+}
+
+func startWSServer(t *testing.T) string {
+	t.Helper()
+
+	srv := httptest.NewServer(wsHandler())
+	t.Cleanup(srv.Close)
+
+	return srv.URL
+}
+
+func TestWSURL(t *testing.T) {
+	tests := []struct {
+		target string
+		tls    bool
+		want   string
+	}{
+		{"ws://example.com/socket", false, "ws://example.com/socket"},
+		{"wss://example.com/socket", false, "wss://example.com/socket"},
+		{"websocket://example.com:8080/socket", false, "ws://example.com:8080/socket"},
+		{"http://example.com/socket", false, "ws://example.com/socket"},
+		{"https://example.com/socket?token=1", false, "wss://example.com/socket?token=1"},
+		{"example.com/socket", false, "example.com/socket"},
+		{"websocket://example.com:443/socket", true, "wss://example.com:443/socket"},
+		{"ws://example.com/socket", true, "wss://example.com/socket"},
+		{"http://example.com/socket", true, "wss://example.com/socket"},
+		{"wss://example.com/socket", true, "wss://example.com/socket"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			assert.Equal(t, tt.want, wsURL(tt.target, tt.tls))
+		})
+	}
+}
+
+func TestWSChecker(t *testing.T) {
+	url := startWSServer(t)
+	auth := map[string]string{"X-Token": "secret"}
+
+	tests := []struct {
+		name    string
+		checker WSChecker
+		target  string
+		wantUp  bool
+		wantMsg string
+	}{
+		{name: "handshake only", checker: WSChecker{Headers: auth}, target: url, wantUp: true},
+		{name: "ws scheme", checker: WSChecker{Headers: auth}, target: "ws://" + strings.TrimPrefix(url, "http://"), wantUp: true},
+		{name: "greeting", checker: WSChecker{Headers: auth, Expect: "^hello$"}, target: url, wantUp: true},
+		{name: "message reply", checker: WSChecker{Headers: auth, Message: "ping", Expect: "^echo:ping$"}, target: url, wantUp: true},
+		{name: "message without expect", checker: WSChecker{Headers: auth, Message: "ping"}, target: url, wantUp: true},
+		{name: "subprotocol accepted", checker: WSChecker{Headers: auth, Subprotocols: []string{"other", "keeplo.v1"}}, target: url, wantUp: true},
+		{name: "subprotocol rejected", checker: WSChecker{Headers: auth, Subprotocols: []string{"other"}}, target: url, wantMsg: "did not accept any subprotocol"},
+		{name: "handshake rejected", target: url, wantMsg: "HTTP status 401"},
+		{name: "invalid expect", checker: WSChecker{Headers: auth, Expect: "("}, target: url, wantMsg: "error parsing regexp"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.checker.Check(context.Background(), tt.target)
+			if tt.wantUp {
+				require.NoError(t, err)
+				assert.Equal(t, "up", res.Status)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, "down", res.Status)
+			assert.Contains(t, res.Message, tt.wantMsg)
+		})
+	}
+}
+
+func TestWSChecker_NoMatchingReply(t *testing.T) {
+	url := startWSServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	res, err := (&WSChecker{Headers: map[string]string{"X-Token": "secret"}, Message: "ping", Expect: "^pong$"}).Check(ctx, url)
+	require.Error(t, err)
+	assert.Equal(t, "down", res.Status)
+	assert.Contains(t, res.Message, `no reply matched /^pong$/ (last: "echo:ping")`)
+	assert.Less(t, time.Since(start), 2*time.Second)
+}
+
+func TestWSChecker_TLS(t *testing.T) {
+	srv := httptest.NewTLSServer(wsHandler())
+	t.Cleanup(srv.Close)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(srv.Certificate())
+	target := "websocket://" + strings.TrimPrefix(srv.URL, "https://")
+	auth := map[string]string{"X-Token": "secret"}
+
+	tests := []struct {
+		name    string
+		checker WSChecker
+		wantUp  bool
+	}{
+		{name: "wss", checker: WSChecker{Headers: auth, TLS: true, RootCAs: pool, Message: "ping", Expect: "^echo:ping$"}, wantUp: true},
+		{name: "skip verify", checker: WSChecker{Headers: auth, TLS: true, InsecureSkipVerify: true}, wantUp: true},
+		{name: "untrusted certificate", checker: WSChecker{Headers: auth, TLS: true, RootCAs: x509.NewCertPool()}},
+		{name: "plain ws to tls server", checker: WSChecker{Headers: auth}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+
+			res, err := tt.checker.Check(ctx, target)
+			if tt.wantUp {
+				require.NoError(t, err)
+				assert.Equal(t, "up", res.Status)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, "down", res.Status)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package idgen
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"strings"
 
 	"github.com/google/uuid"
@@ -25,3 +27,12 @@ func GenerateShortUUID(n int) string {
 func GenerateTraceID() string {
 	return uuid.NewString()[:8]
 }
+
+// 서명 키처럼 추측할 수 없어야 하는 값. n 바이트 난수의 hex 문자열
+func GenerateSecret(n int) string {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,83 @@
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// 암호화된 값 앞에 붙는 접두사. 접두사가 없는 값은 평문으로 취급
+const prefix = "enc:v1:"
+
+var ErrNoKey = errors.New("secret key is not configured")
+
+// 설정 키에서 유도한 AES-256-GCM 키로 문자열 암복호화
+type Box struct {
+	aead cipher.AEAD
+}
+
+// key 가 비어 있으면 Seal 은 ErrNoKey 를 반환하고, Open 은 평문 값만 통과시킴
+func NewBox(key string) (*Box, error) {
+	if key == "" {
+		return &Box{}, nil
+	}
+
+	sum := sha256.Sum256([]byte(key))
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Box{aead: aead}, nil
+}
+
+func (b *Box) Seal(plain string) (string, error) {
+	if plain == "" || IsSealed(plain) {
+		return plain, nil
+	}
+	if b.aead == nil {
+		return "", ErrNoKey
+	}
+
+	nonce := make([]byte, b.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := b.aead.Seal(nonce, nonce, []byte(plain), nil)
+	return prefix + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+func (b *Box) Open(value string) (string, error) {
+	if !IsSealed(value) {
+		return value, nil
+	}
+	if b.aead == nil {
+		return "", ErrNoKey
+	}
+
+	raw, err := base64.RawStdEncoding.DecodeString(strings.TrimPrefix(value, prefix))
+	if err != nil {
+		return "", fmt.Errorf("decode sealed value: %w", err)
+	}
+	n := b.aead.NonceSize()
+	if len(raw) < n {
+		return "", errors.New("sealed value too short")
+	}
+	plain, err := b.aead.Open(nil, raw[:n], raw[n:], nil)
+	if err != nil {
+		return "", fmt.Errorf("open sealed value: %w", err)
+	}
+	return string(plain), nil
+}
+
+func IsSealed(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
@@ -0,0 +1,46 @@
+package secret
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBox_SealOpen(t *testing.T) {
+	box, err := NewBox("test-key")
+	require.NoError(t, err)
+
+	sealed, err := box.Seal("s3cr3t")
+	require.NoError(t, err)
+	assert.True(t, IsSealed(sealed))
+	assert.NotContains(t, sealed, "s3cr3t")
+
+	again, err := box.Seal(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, sealed, again, "already sealed value must not be sealed twice")
+
+	plain, err := box.Open(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", plain)
+
+	other, _ := NewBox("other-key")
+	_, err = other.Open(sealed)
+	assert.Error(t, err)
+}
+
+func TestBox_NoKey(t *testing.T) {
+	box, err := NewBox("")
+	require.NoError(t, err)
+
+	_, err = box.Seal("s3cr3t")
+	assert.ErrorIs(t, err, ErrNoKey)
+
+	empty, err := box.Seal("")
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+
+	plain, err := box.Open("legacy-plain")
+	require.NoError(t, err)
+	assert.Equal(t, "legacy-plain", plain)
+}